@@ -0,0 +1,52 @@
+package hold
+
+import "reflect"
+
+// Equal returns true if the provided collections have the same number of entries, and the entries are equivalent in
+// iteration order, otherwise false is returned.
+//
+// Entries are compared using reflect.DeepEqual, and a nil Collection is equal to an empty one.
+func Equal[E comparable](a, b Collection[E]) bool {
+	av, bv := values(a), values(b)
+	if len(av) != len(bv) {
+		return false
+	}
+
+	for i := range av {
+		if !reflect.DeepEqual(av[i], bv[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualUnordered returns true if the provided collections contain the same entries the same number of times,
+// regardless of iteration order, otherwise false is returned.
+//
+// Entries are compared using the == operator, and a nil Collection is equal to an empty one.
+func EqualUnordered[E comparable](a, b Collection[E]) bool {
+	av, bv := values(a), values(b)
+	if len(av) != len(bv) {
+		return false
+	}
+
+	counts := make(map[E]int, len(av))
+	for _, e := range av {
+		counts[e]++
+	}
+
+	for _, e := range bv {
+		if counts[e] == 0 {
+			return false
+		}
+		counts[e]--
+	}
+	return true
+}
+
+func values[E comparable](c Collection[E]) []E {
+	if c == nil {
+		return nil
+	}
+	return c.Values()
+}
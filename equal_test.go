@@ -0,0 +1,70 @@
+package hold_test
+
+import (
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+	"github.com/transientvariable/hold/trie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("luffy", "nami", "zoro"))
+
+	tests := []struct {
+		name      string
+		a         hold.Collection[string]
+		b         hold.Collection[string]
+		ordered   bool
+		unordered bool
+	}{
+		{
+			name:      "Equal",
+			a:         &list.List[string]{"luffy", "nami", "zoro"},
+			b:         tr,
+			ordered:   true,
+			unordered: true,
+		},
+		{
+			name:      "DifferentOrder",
+			a:         &list.List[string]{"zoro", "luffy", "nami"},
+			b:         tr,
+			ordered:   false,
+			unordered: true,
+		},
+		{
+			name:      "DifferentLength",
+			a:         &list.List[string]{"luffy", "nami"},
+			b:         tr,
+			ordered:   false,
+			unordered: false,
+		},
+		{
+			name:      "DifferentCounts",
+			a:         &list.List[string]{"luffy", "luffy", "nami"},
+			b:         &list.List[string]{"luffy", "nami", "nami"},
+			ordered:   false,
+			unordered: false,
+		},
+		{
+			name:      "Empty",
+			a:         &list.List[string]{},
+			b:         nil,
+			ordered:   true,
+			unordered: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.ordered, hold.Equal(tc.a, tc.b))
+			assert.Equal(t, tc.ordered, hold.Equal(tc.b, tc.a))
+			assert.Equal(t, tc.unordered, hold.EqualUnordered(tc.a, tc.b))
+			assert.Equal(t, tc.unordered, hold.EqualUnordered(tc.b, tc.a))
+		})
+	}
+}
@@ -3,8 +3,10 @@ package hold
 const (
 	ErrNoMoreElements   = collectionError("no more elements")
 	ErrBoundsOutOfRange = collectionError("index bounds out of range")
+	ErrCapacityReached  = collectionError("collection is at capacity")
 	ErrCollectionEmpty  = collectionError("collection is empty")
 	ErrNotFound         = collectionError("entry not found")
+	ErrUnmodifiable     = collectionError("collection is unmodifiable")
 	ErrValueRequired    = collectionError("value is required")
 )
 
@@ -0,0 +1,224 @@
+package heap
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/transientvariable/hold"
+)
+
+var _ hold.Collection[any] = (*PriorityQueue[any])(nil)
+
+type iterator[E comparable] struct {
+	entries []E
+	index   int
+}
+
+func (i *iterator[E]) HasNext() bool {
+	return i.index < len(i.entries)
+}
+
+func (i *iterator[E]) Next() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("heap_iter: %w", hold.ErrNoMoreElements)
+	}
+	n = i.entries[i.index]
+	i.index++
+	return n, nil
+}
+
+// PriorityQueue is an implementation of a Collection that removes its entries in priority order, backed by a binary
+// heap, which supports O(log n) insertion and removal of the entry with the highest priority.
+//
+// Entries are prioritized by the comparator provided to New, where the entry ordered first by the comparator has the
+// highest priority. For example, cmp.Compare yields a min-heap, in which Pop removes the least entry, while a
+// comparator with its arguments reversed yields a max-heap. The iteration order of a PriorityQueue is from the highest
+// to the lowest priority. This implementation does not make any guarantees for concurrent access.
+type PriorityQueue[E comparable] struct {
+	cmp     func(a, b E) int
+	entries []E
+}
+
+// New creates a new PriorityQueue whose entries are prioritized by the provided comparator, and pushes the provided
+// entries onto it.
+//
+// The comparator should return a negative number when a has a higher priority than b, a positive number when a has a
+// lower priority than b, and zero when a and b have the same priority.
+func New[E comparable](cmp func(a, b E) int, entries ...E) *PriorityQueue[E] {
+	q := &PriorityQueue[E]{cmp: cmp}
+	_ = q.Add(entries...)
+	return q
+}
+
+// Add pushes the provided entries onto the PriorityQueue.
+func (q *PriorityQueue[E]) Add(entry ...E) error {
+	for _, e := range entry {
+		q.Push(e)
+	}
+	return nil
+}
+
+// AddAll pushes all entries from the provided collection onto the PriorityQueue.
+func (q *PriorityQueue[E]) AddAll(collection hold.Collection[E]) error {
+	if collection != nil {
+		return q.Add(collection.Values()...)
+	}
+	return nil
+}
+
+// Clear removes all entries from the PriorityQueue.
+func (q *PriorityQueue[E]) Clear() {
+	clear(q.entries)
+	q.entries = q.entries[:0]
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the PriorityQueue, otherwise false is
+// returned.
+func (q *PriorityQueue[E]) Contains(value E) bool {
+	return q.indexOf(value) >= 0
+}
+
+// IsEmpty returns true if the PriorityQueue contains no entries, otherwise false is returned.
+func (q *PriorityQueue[E]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+// Iterate returns the collection.Iterator for the PriorityQueue.
+//
+// The returned iterator visits the entries that were in the PriorityQueue at the time of the call, from the highest to
+// the lowest priority.
+func (q *PriorityQueue[E]) Iterate() hold.Iterator[E] {
+	return &iterator[E]{entries: q.Values()}
+}
+
+// Len returns the number of entries in the PriorityQueue.
+func (q *PriorityQueue[E]) Len() int {
+	return len(q.entries)
+}
+
+// Peek returns the entry with the highest priority without removing it.
+//
+// The returned error will be non-nil if the PriorityQueue is empty.
+func (q *PriorityQueue[E]) Peek() (E, error) {
+	if q.IsEmpty() {
+		var e E
+		return e, fmt.Errorf("heap: %w", hold.ErrCollectionEmpty)
+	}
+	return q.entries[0], nil
+}
+
+// Pop removes the entry with the highest priority and returns it.
+//
+// The returned error will be non-nil if the PriorityQueue is empty.
+func (q *PriorityQueue[E]) Pop() (E, error) {
+	if q.IsEmpty() {
+		var e E
+		return e, fmt.Errorf("heap: %w", hold.ErrCollectionEmpty)
+	}
+	return q.removeAt(0), nil
+}
+
+// Push inserts the provided entry into the PriorityQueue.
+func (q *PriorityQueue[E]) Push(entry E) {
+	q.entries = append(q.entries, entry)
+	q.up(len(q.entries) - 1)
+}
+
+// Remove removes an occurrence (if any) of an entry equivalent to the provided value.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (q *PriorityQueue[E]) Remove(value E) (bool, error) {
+	i := q.indexOf(value)
+	if i < 0 {
+		return false, nil
+	}
+	q.removeAt(i)
+	return true, nil
+}
+
+// Values returns a slice containing the entries in the PriorityQueue in the iteration order, from the highest to the
+// lowest priority.
+func (q *PriorityQueue[E]) Values() []E {
+	entries := slices.Clone(q.entries)
+	slices.SortFunc(entries, q.cmp)
+	return entries
+}
+
+// String returns a string representation of the PriorityQueue in it's current state, from the highest to the lowest
+// priority.
+func (q *PriorityQueue[E]) String() string {
+	if q.Len() == 0 {
+		return "[]"
+	}
+
+	entries := make([]string, 0, q.Len())
+	for _, e := range q.Values() {
+		entries = append(entries, fmt.Sprintf("%v", e))
+	}
+	return "[" + strings.Join(entries, ", ") + "]"
+}
+
+// down moves the entry at the provided index towards the leaves until neither of its children has a higher priority,
+// and returns whether the entry was moved.
+func (q *PriorityQueue[E]) down(i int) bool {
+	start := i
+	for {
+		c := 2*i + 1
+		if c >= len(q.entries) {
+			break
+		}
+
+		if r := c + 1; r < len(q.entries) && q.cmp(q.entries[r], q.entries[c]) < 0 {
+			c = r
+		}
+
+		if q.cmp(q.entries[c], q.entries[i]) >= 0 {
+			break
+		}
+		q.entries[i], q.entries[c] = q.entries[c], q.entries[i]
+		i = c
+	}
+	return i > start
+}
+
+// indexOf returns the index of the first entry in the underlying slice that is equivalent to the provided value, or -1
+// if there is no such entry.
+func (q *PriorityQueue[E]) indexOf(value E) int {
+	for i, e := range q.entries {
+		if reflect.DeepEqual(e, value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeAt removes the entry at the provided index by replacing it with the last entry, which is then moved to restore
+// the heap order.
+func (q *PriorityQueue[E]) removeAt(i int) E {
+	var zero E
+	e := q.entries[i]
+	last := len(q.entries) - 1
+	q.entries[i] = q.entries[last]
+	q.entries[last] = zero
+	q.entries = q.entries[:last]
+
+	if i < last && !q.down(i) {
+		q.up(i)
+	}
+	return e
+}
+
+// up moves the entry at the provided index towards the root until its parent does not have a lower priority.
+func (q *PriorityQueue[E]) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if q.cmp(q.entries[i], q.entries[p]) >= 0 {
+			break
+		}
+		q.entries[i], q.entries[p] = q.entries[p], q.entries[i]
+		i = p
+	}
+}
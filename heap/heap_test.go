@@ -0,0 +1,195 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityQueue_MinHeap(t *testing.T) {
+	q := New(cmp.Compare[int])
+	assert.True(t, q.IsEmpty())
+
+	_, err := q.Pop()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	_, err = q.Peek()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	for _, v := range []int{42, 7, 19, 3, 88, 61, 7} {
+		q.Push(v)
+	}
+	assert.Equal(t, 7, q.Len())
+	assertContentEquals(t, q, "[3, 7, 7, 19, 42, 61, 88]")
+
+	v, err := q.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 7, q.Len())
+
+	var popped []int
+	for !q.IsEmpty() {
+		v, err := q.Pop()
+		assert.NoError(t, err)
+		popped = append(popped, v)
+	}
+	assert.Equal(t, []int{3, 7, 7, 19, 42, 61, 88}, popped)
+
+	_, err = q.Pop()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+}
+
+func TestPriorityQueue_MaxHeap(t *testing.T) {
+	q := New(func(a, b string) int { return cmp.Compare(b, a) }, "luffy", "zoro", "sanji", "nami")
+	assertContentEquals(t, q, "[zoro, sanji, nami, luffy]")
+
+	v, err := q.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "zoro", v)
+
+	for _, expected := range []string{"zoro", "sanji", "nami", "luffy"} {
+		v, err := q.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+	}
+	assert.True(t, q.IsEmpty())
+}
+
+func TestPriorityQueue_Collection(t *testing.T) {
+	q := New(cmp.Compare[int], 5, 1, 4)
+
+	err := q.AddAll(&list.List[int]{2, 3})
+	assert.NoError(t, err)
+	assertContentEquals(t, q, "[1, 2, 3, 4, 5]")
+	assert.True(t, q.Contains(4))
+	assert.False(t, q.Contains(6))
+
+	r, err := q.Remove(3)
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assertContentEquals(t, q, "[1, 2, 4, 5]")
+
+	r, err = q.Remove(6)
+	assert.NoError(t, err)
+	assert.False(t, r)
+
+	iter := q.Iterate()
+	var values []int
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{1, 2, 4, 5}, values)
+
+	_, err = iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+	assertContentEquals(t, q, "[]")
+
+	q.Push(7)
+	assertContentEquals(t, q, "[7]")
+}
+
+func TestPriorityQueue_DeepEqual(t *testing.T) {
+	type entry struct {
+		priority int
+		tags     any
+	}
+
+	q := New(func(a, b entry) int { return cmp.Compare(a.priority, b.priority) },
+		entry{priority: 2, tags: []string{"b"}},
+		entry{priority: 1, tags: []string{"a"}},
+	)
+
+	// Entries holding uncomparable values are compared using reflect.DeepEqual rather than ==, which would panic.
+	assert.True(t, q.Contains(entry{priority: 1, tags: []string{"a"}}))
+	assert.False(t, q.Contains(entry{priority: 1, tags: []string{"b"}}))
+
+	r, err := q.Remove(entry{priority: 2, tags: []string{"b"}})
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestPriorityQueue_Random(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	q := New(cmp.Compare[int])
+
+	var values []int
+	for range 1000 {
+		v := r.IntN(500)
+		values = append(values, v)
+		q.Push(v)
+	}
+
+	// Removing arbitrary entries must leave the heap order intact for the entries that remain.
+	for _, v := range values[:200] {
+		removed, err := q.Remove(v)
+		assert.NoError(t, err)
+		assert.True(t, removed)
+	}
+
+	expected := slices.Clone(values[200:])
+	slices.Sort(expected)
+
+	var popped []int
+	for !q.IsEmpty() {
+		v, err := q.Pop()
+		assert.NoError(t, err)
+		popped = append(popped, v)
+	}
+	assert.Equal(t, expected, popped)
+}
+
+func BenchmarkPriorityQueue(b *testing.B) {
+	const size = 10000
+
+	r := rand.New(rand.NewPCG(1, 2))
+	values := make([]int, size)
+	for i := range values {
+		values[i] = r.Int()
+	}
+
+	b.Run("PriorityQueue", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			q := New(cmp.Compare[int])
+			for _, v := range values {
+				q.Push(v)
+			}
+
+			for !q.IsEmpty() {
+				if _, err := q.Pop(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("Sort", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s := slices.Clone(values)
+			slices.Sort(s)
+		}
+	})
+}
+
+func assertContentEquals[E comparable](t *testing.T, collection hold.Collection[E], expected string) {
+	t.Helper()
+
+	actual := fmt.Sprintf("%s", collection)
+	if actual != expected {
+		t.Errorf("expected content of '%s', but found '%s'", expected, actual)
+	}
+}
@@ -1,7 +1,10 @@
 package hold
 
 // Iterator iterates over entries in a Collection.
-type Iterator[E comparable] interface {
+//
+// Unlike a Collection, the entries visited by an Iterator need not be comparable, so that an Iterator can also visit
+// values derived from the entries of a Collection, such as the chunks visited by the Iterator returned by Chunk.
+type Iterator[E any] interface {
 	// HasNext returns whether the iterator has more entries.
 	HasNext() bool
 
@@ -11,6 +14,54 @@ type Iterator[E comparable] interface {
 	Next() (E, error)
 }
 
+// BidirectionalIterator iterates over entries in a Collection in either direction.
+//
+// The iterator maintains a cursor that lies between entries. A call to Next followed by a call to Previous (or vice
+// versa) returns the same entry.
+type BidirectionalIterator[E comparable] interface {
+	Iterator[E]
+
+	// HasPrevious returns whether the iterator has more entries when traversing in the reverse direction.
+	HasPrevious() bool
+
+	// Previous returns the previous entry in the iteration.
+	//
+	// If no further entries remain (HasPrevious() returns false), collection.ErrNoMoreElements should be returned.
+	Previous() (E, error)
+}
+
+// PeekableIterator is an Iterator that can return the next entry without consuming it.
+type PeekableIterator[E comparable] interface {
+	Iterator[E]
+
+	// Peek returns the entry that the following call to Next would return without advancing the iterator.
+	//
+	// If no further entries remain (HasNext() returns false), collection.ErrNoMoreElements should be returned.
+	Peek() (E, error)
+}
+
+// ResettableIterator is an Iterator that can be rewound to the start of the iteration so that the same instance can be
+// reused.
+type ResettableIterator[E comparable] interface {
+	Iterator[E]
+
+	// Reset moves the iterator back to the start of the iteration, such that the following call to Next returns the
+	// first entry.
+	Reset()
+}
+
+// MutableIterator is an Iterator that can remove entries from the underlying Collection during iteration.
+type MutableIterator[E comparable] interface {
+	Iterator[E]
+
+	// Remove removes the entry returned by the last call to Next from the underlying Collection. Iteration continues
+	// with the entry that followed the removed entry.
+	//
+	// Remove may be called at most once per call to Next. If Next has not been called, or Remove has already been
+	// called after the last call to Next, collection.ErrNotFound should be returned.
+	Remove() error
+}
+
 // Collection defines the behavior for maintaining a collection of elements.
 type Collection[E comparable] interface {
 	// Add inserts the provided entries into the Collection.
@@ -76,9 +127,9 @@ type Sequence[E comparable] interface {
 
 	// AddAt inserts the provided entry into the Sequence specified by index.
 	//
-	// The position of the entries that were at positions index to Sequence.Size() - 1 increase by one. The returned
-	// error will be non-nil if the provided index is outside the current bounds of the Sequence
-	// (index < 0 || index > Sequence.Size() - 1).
+	// The position of the entries that were at positions index to Sequence.Size() - 1 increase by one, and an index
+	// equal to Sequence.Size() appends the entry. The returned error will be non-nil if the provided index is outside
+	// the current bounds of the Sequence (index < 0 || index > Sequence.Size()).
 	AddAt(index int, entry E) error
 
 	// AddFirst inserts the provided entry at the front (index == 0) of the Sequence.
@@ -99,6 +150,14 @@ type Sequence[E comparable] interface {
 	// -1.
 	Index(entry E) (int, error)
 
+	// IndexFrom returns the position of the first occurrence (if any) of an entry equivalent to the provided entry at or
+	// after the provided start position, which allows each subsequent occurrence to be found from the position after
+	// the previous one.
+	//
+	// The returned error will be non-nil if the provided start position is outside the current bounds of the Sequence
+	// (start < 0 || start > Sequence.Size()), or if no such entry is found, and the returned index will be -1.
+	IndexFrom(entry E, start int) (int, error)
+
 	// RemoveAt removes the entry at the provided index from the Sequence and returns it.
 	//
 	// The positions of the entries originally at positions index + 1 to Sequence.Size() - 1 are decremented by 1. The
@@ -0,0 +1,183 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/transientvariable/hold"
+)
+
+var _ hold.Sequence[any] = (*Bounded[any])(nil)
+
+// Bounded is a Sequence backed by a List that holds at most a fixed number of entries.
+//
+// Operations that would grow the Bounded list beyond its capacity fail with hold.ErrCapacityReached and leave the
+// Bounded list unchanged. This implementation does not make any guarantees for concurrent access.
+type Bounded[E comparable] struct {
+	capacity int
+	list     List[E]
+}
+
+// NewBounded creates a new, empty Bounded list that holds at most the provided number of entries.
+//
+// A capacity less than zero is treated as zero.
+func NewBounded[E comparable](capacity int) *Bounded[E] {
+	return &Bounded[E]{capacity: max(capacity, 0)}
+}
+
+// Add inserts the provided entries into the Bounded list.
+//
+// The returned error will be non-nil if inserting all the provided entries would exceed the capacity of the Bounded
+// list, in which case none of them are inserted.
+func (b *Bounded[E]) Add(entry ...E) error {
+	if err := b.checkCapacity(len(entry)); err != nil {
+		return err
+	}
+	return b.list.Add(entry...)
+}
+
+// AddAll inserts all entries from the provided collection into the Bounded list.
+//
+// The returned error will be non-nil if inserting all the entries would exceed the capacity of the Bounded list, in
+// which case none of them are inserted.
+func (b *Bounded[E]) AddAll(collection hold.Collection[E]) error {
+	if collection == nil {
+		return nil
+	}
+	return b.Add(collection.Values()...)
+}
+
+// AddAt inserts the provided entry into the Bounded list specified by index.
+//
+// The position of the entries that were at positions index to Bounded.Size() - 1 increase by one. The returned error
+// will be non-nil if the Bounded list is at capacity, or if the provided index is outside the current bounds of the
+// Bounded list (index < 0 || index > Bounded.Size()).
+func (b *Bounded[E]) AddAt(index int, entry E) error {
+	if err := b.checkCapacity(1); err != nil {
+		return err
+	}
+	return b.list.AddAt(index, entry)
+}
+
+// AddFirst inserts the provided value at the front (index == 0) of the Bounded list.
+//
+// The returned error will be non-nil if the Bounded list is at capacity.
+func (b *Bounded[E]) AddFirst(value E) error {
+	if err := b.checkCapacity(1); err != nil {
+		return err
+	}
+	return b.list.AddFirst(value)
+}
+
+// AddLast inserts the provided value at the end of the Bounded list (index == Bounded.Size()).
+//
+// The returned error will be non-nil if the Bounded list is at capacity.
+func (b *Bounded[E]) AddLast(value E) error {
+	if err := b.checkCapacity(1); err != nil {
+		return err
+	}
+	return b.list.AddLast(value)
+}
+
+// Cap returns the maximum number of entries the Bounded list can hold.
+func (b *Bounded[E]) Cap() int {
+	return b.capacity
+}
+
+// Clear removes all entries from the Bounded list.
+func (b *Bounded[E]) Clear() {
+	b.list.Clear()
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the Bounded list, otherwise false is
+// returned.
+func (b *Bounded[E]) Contains(value E) bool {
+	return b.list.Contains(value)
+}
+
+// Index returns the position of the first occurrence (if any) of an entry equivalent to the provided entry.
+//
+// The returned error will be non-nil if provided entry is not found in the Bounded list.
+func (b *Bounded[E]) Index(value E) (int, error) {
+	return b.list.Index(value)
+}
+
+// IndexFrom returns the position of the first occurrence (if any) of an entry equivalent to the provided entry at or
+// after the provided start position.
+//
+// The returned error will be non-nil if the provided start position is outside the current bounds of the Bounded list,
+// or if no such entry is found.
+func (b *Bounded[E]) IndexFrom(value E, start int) (int, error) {
+	return b.list.IndexFrom(value, start)
+}
+
+// IsEmpty returns true if the Bounded list contains no entries, otherwise false is returned.
+func (b *Bounded[E]) IsEmpty() bool {
+	return b.list.IsEmpty()
+}
+
+// IsFull returns true if the Bounded list holds as many entries as its capacity allows, otherwise false is returned.
+func (b *Bounded[E]) IsFull() bool {
+	return b.list.Len() >= b.capacity
+}
+
+// Iterate returns the collection.Iterator for the Bounded list.
+func (b *Bounded[E]) Iterate() hold.Iterator[E] {
+	return b.list.Iterate()
+}
+
+// Len returns the number of entries in the Bounded list.
+func (b *Bounded[E]) Len() int {
+	return b.list.Len()
+}
+
+// Remove removes the first occurrence (if any) of an entry equivalent to the provided value.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (b *Bounded[E]) Remove(value E) (bool, error) {
+	return b.list.Remove(value)
+}
+
+// RemoveAt removes the entry at the provided index from the Bounded list and returns it.
+//
+// The returned error will be non-nil if the provided index is outside the bounds of the Bounded list.
+func (b *Bounded[E]) RemoveAt(index int) (E, error) {
+	return b.list.RemoveAt(index)
+}
+
+// RemoveFirst removes the entry at the front (index == 0) of the Bounded list and returns it.
+func (b *Bounded[E]) RemoveFirst() (E, error) {
+	return b.list.RemoveFirst()
+}
+
+// RemoveLast removes the entry at the end (index == Bounded.Size() - 1) of the Bounded list and returns it.
+func (b *Bounded[E]) RemoveLast() (E, error) {
+	return b.list.RemoveLast()
+}
+
+// ValueAt returns the entry at the position specified by the provided index.
+//
+// The returned error will be non-nil if the provided index is outside the current bounds of the Bounded list.
+func (b *Bounded[E]) ValueAt(index int) (E, error) {
+	return b.list.ValueAt(index)
+}
+
+// Values returns a slice containing the entries in the Bounded list in the iteration order.
+func (b *Bounded[E]) Values() []E {
+	return b.list.Values()
+}
+
+// String returns a string representation of the Bounded list in it's current state.
+func (b *Bounded[E]) String() string {
+	return b.list.String()
+}
+
+func (b *Bounded[E]) checkCapacity(n int) error {
+	if b.list.Len()+n > b.capacity {
+		return fmt.Errorf("list: capacity = %d, size = %d, requested entries = %d: %w",
+			b.capacity,
+			b.list.Len(),
+			n,
+			hold.ErrCapacityReached)
+	}
+	return nil
+}
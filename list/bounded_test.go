@@ -0,0 +1,57 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/transientvariable/hold"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBounded(t *testing.T) {
+	t.Run("Fill", func(t *testing.T) {
+		list := NewBounded[string](3)
+		assert.Equal(t, 3, list.Cap())
+
+		assertError(t, list.Add("luffy"), nil)
+		assertError(t, list.AddFirst("zoro"), nil)
+		assertError(t, list.AddLast("nami"), nil)
+		assert.True(t, list.IsFull())
+
+		assertError(t, list.Add("sanji"), hold.ErrCapacityReached)
+		assertError(t, list.AddAt(0, "sanji"), hold.ErrCapacityReached)
+		assertError(t, list.AddFirst("sanji"), hold.ErrCapacityReached)
+		assertError(t, list.AddLast("sanji"), hold.ErrCapacityReached)
+		assert.Equal(t, []string{"zoro", "luffy", "nami"}, list.Values())
+
+		r, err := list.Remove("luffy")
+		assertError(t, err, nil)
+		assert.True(t, r)
+		assert.False(t, list.IsFull())
+
+		assertError(t, list.AddAt(1, "sanji"), nil)
+		assert.Equal(t, []string{"zoro", "sanji", "nami"}, list.Values())
+	})
+
+	t.Run("AddAll", func(t *testing.T) {
+		list := NewBounded[int](4)
+		assertError(t, list.Add(1, 2), nil)
+
+		assertError(t, list.AddAll(&List[int]{3, 4, 5}), hold.ErrCapacityReached)
+		assert.Equal(t, []int{1, 2}, list.Values())
+
+		assertError(t, list.Add(3, 4, 5), hold.ErrCapacityReached)
+		assert.Equal(t, []int{1, 2}, list.Values())
+
+		assertError(t, list.AddAll(&List[int]{3, 4}), nil)
+		assert.Equal(t, []int{1, 2, 3, 4}, list.Values())
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		list := NewBounded[int](-1)
+		assert.Equal(t, 0, list.Cap())
+		assert.True(t, list.IsFull())
+		assertError(t, list.Add(1), hold.ErrCapacityReached)
+		assertError(t, list.Add(), nil)
+	})
+}
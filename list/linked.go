@@ -0,0 +1,363 @@
+package list
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/transientvariable/hold"
+)
+
+var (
+	_ hold.Sequence[any]           = (*LinkedList[any])(nil)
+	_ hold.MutableIterator[any]    = (*linkedIterator[any])(nil)
+	_ hold.PeekableIterator[any]   = (*linkedIterator[any])(nil)
+	_ hold.ResettableIterator[any] = (*linkedIterator[any])(nil)
+)
+
+type element[E comparable] struct {
+	next     *element[E]
+	previous *element[E]
+	value    E
+}
+
+// addAfter links the element into the list directly after the provided element.
+func (e *element[E]) addAfter(previous *element[E]) {
+	e.next = previous.next
+	e.previous = previous
+	previous.next = e
+	e.next.previous = e
+}
+
+// remove unlinks the element from the list, marking it as deleted.
+func (e *element[E]) remove() {
+	e.previous.next = e.next
+	e.next.previous = e.previous
+	e.next = nil
+	e.previous = nil
+}
+
+type linkedIterator[E comparable] struct {
+	last    *element[E]
+	list    *LinkedList[E]
+	pointer *element[E]
+}
+
+func newLinkedIterator[E comparable](list *LinkedList[E]) *linkedIterator[E] {
+	list.init()
+	return &linkedIterator[E]{list: list, pointer: list.head}
+}
+
+func (i *linkedIterator[E]) HasNext() bool {
+	return i.pointer.next != nil && i.pointer.next != i.list.tail
+}
+
+func (i *linkedIterator[E]) Next() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("list_iter: %w", hold.ErrNoMoreElements)
+	}
+	i.pointer = i.pointer.next
+	i.last = i.pointer
+	return i.pointer.value, nil
+}
+
+func (i *linkedIterator[E]) Peek() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("list_iter: %w", hold.ErrNoMoreElements)
+	}
+	return i.pointer.next.value, nil
+}
+
+// Remove removes the entry returned by the last call to Next from the LinkedList, so that the following call to Next
+// returns the entry after the removed entry.
+func (i *linkedIterator[E]) Remove() error {
+	if i.last == nil {
+		return fmt.Errorf("list_iter: %w", hold.ErrNotFound)
+	}
+
+	i.pointer = i.last.previous
+	i.list.unlink(i.last)
+	i.last = nil
+	return nil
+}
+
+func (i *linkedIterator[E]) Reset() {
+	i.pointer = i.list.head
+	i.last = nil
+}
+
+// LinkedList is an implementation of a Sequence backed by a doubly-linked list.
+//
+// Unlike List, entries can be inserted or removed at either end of a LinkedList in constant time, while accessing an
+// entry by position takes time proportional to its distance from the nearest end. The zero value is an empty
+// LinkedList ready to use. This implementation does not make any guarantees for concurrent access.
+type LinkedList[E comparable] struct {
+	head *element[E]
+	size int
+	tail *element[E]
+}
+
+// NewLinkedList creates a new LinkedList containing the provided entries.
+func NewLinkedList[E comparable](entries ...E) *LinkedList[E] {
+	l := &LinkedList[E]{}
+	_ = l.Add(entries...)
+	return l
+}
+
+// Add inserts the provided entries at the end of the LinkedList.
+func (l *LinkedList[E]) Add(entry ...E) error {
+	for _, e := range entry {
+		l.linkBefore(l.tail, e)
+	}
+	return nil
+}
+
+// AddAll inserts all entries from the provided collection at the end of the LinkedList.
+func (l *LinkedList[E]) AddAll(collection hold.Collection[E]) error {
+	if collection != nil {
+		return l.Add(collection.Values()...)
+	}
+	return nil
+}
+
+// AddAt inserts the provided entry into the LinkedList specified by index.
+//
+// The position of the entries that were at positions index to LinkedList.Size() - 1 increase by one. The returned
+// error will be non-nil if the provided index is outside the current bounds of the LinkedList
+// (index < 0 || index > LinkedList.Size()).
+func (l *LinkedList[E]) AddAt(index int, entry E) error {
+	if index < 0 || index > l.size {
+		return fmt.Errorf("list: size = %d, requested index = %d: %w", l.size, index, hold.ErrBoundsOutOfRange)
+	}
+
+	if index == l.size {
+		return l.AddLast(entry)
+	}
+
+	e, err := l.elementAt(index)
+	if err != nil {
+		return err
+	}
+	l.linkBefore(e, entry)
+	return nil
+}
+
+// AddFirst inserts the provided value at the front (index == 0) of the LinkedList in constant time.
+//
+// The positions of the existing entries are increased by one.
+func (l *LinkedList[E]) AddFirst(value E) error {
+	l.init()
+	l.link(l.head, value)
+	return nil
+}
+
+// AddLast inserts the provided value at the end of the LinkedList (index == LinkedList.Size()) in constant time.
+func (l *LinkedList[E]) AddLast(value E) error {
+	l.linkBefore(l.tail, value)
+	return nil
+}
+
+// Clear removes all entries from the LinkedList.
+func (l *LinkedList[E]) Clear() {
+	*l = LinkedList[E]{}
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the LinkedList, otherwise false is
+// returned.
+func (l *LinkedList[E]) Contains(value E) bool {
+	_, err := l.Index(value)
+	return err == nil
+}
+
+// Index returns the position of the first occurrence (if any) of an entry equivalent to the provided entry.
+//
+// The returned error will be non-nil if provided entry is not found in the LinkedList, and the returned index will be
+// -1.
+func (l *LinkedList[E]) Index(value E) (int, error) {
+	return l.IndexFrom(value, 0)
+}
+
+// IndexFrom returns the position of the first occurrence (if any) of an entry equivalent to the provided entry at or
+// after the provided start position.
+//
+// The returned error will be non-nil if the provided start position is outside the current bounds of the LinkedList
+// (start < 0 || start > LinkedList.Size()), or if no such entry is found, and the returned index will be -1.
+func (l *LinkedList[E]) IndexFrom(value E, start int) (int, error) {
+	if start < 0 || start > l.size {
+		return -1, fmt.Errorf("list: size = %d, requested index = %d: %w", l.size, start, hold.ErrBoundsOutOfRange)
+	}
+
+	if start < l.size {
+		e, err := l.elementAt(start)
+		if err != nil {
+			return -1, err
+		}
+
+		for i := start; e != l.tail; e = e.next {
+			if reflect.DeepEqual(e.value, value) {
+				return i, nil
+			}
+			i++
+		}
+	}
+	return -1, fmt.Errorf("list: %w", hold.ErrNotFound)
+}
+
+// IsEmpty returns true if the LinkedList contains no entries, otherwise false is returned.
+func (l *LinkedList[E]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Iterate returns the collection.Iterator for the LinkedList.
+//
+// The returned iterator also implements hold.MutableIterator, hold.PeekableIterator and hold.ResettableIterator.
+func (l *LinkedList[E]) Iterate() hold.Iterator[E] {
+	return newLinkedIterator(l)
+}
+
+// Len returns the number of entries in the LinkedList.
+func (l *LinkedList[E]) Len() int {
+	return l.size
+}
+
+// Remove removes the first occurrence (if any) of an entry equivalent to the provided value.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (l *LinkedList[E]) Remove(value E) (bool, error) {
+	if l.size > 0 {
+		for e := l.head.next; e != l.tail; e = e.next {
+			if reflect.DeepEqual(e.value, value) {
+				l.unlink(e)
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// RemoveAt removes the entry at the provided index from the LinkedList and returns it.
+//
+// The positions of the entries original positions index + 1 to LinkedList.Size() - 1 are decremented by 1. The
+// returned error will be non-nil if the provided index is outside the bounds of the LinkedList
+// (index < 0 || index > LinkedList.Size() - 1).
+func (l *LinkedList[E]) RemoveAt(index int) (E, error) {
+	e, err := l.elementAt(index)
+	if err != nil {
+		var entry E
+		return entry, err
+	}
+	l.unlink(e)
+	return e.value, nil
+}
+
+// RemoveFirst removes the entry at the front (index == 0) of the LinkedList and returns it in constant time.
+//
+// If the LinkedList is empty (LinkedList.Size() == 0), the return value will be nil.
+func (l *LinkedList[E]) RemoveFirst() (E, error) {
+	var entry E
+	if l.size > 0 {
+		e := l.head.next
+		l.unlink(e)
+		entry = e.value
+	}
+	return entry, nil
+}
+
+// RemoveLast removes the entry at the end (index == LinkedList.Size() - 1) of the LinkedList and returns it in
+// constant time.
+//
+// If the LinkedList is empty (LinkedList.Size() == 0), the return value will be nil.
+func (l *LinkedList[E]) RemoveLast() (E, error) {
+	var entry E
+	if l.size > 0 {
+		e := l.tail.previous
+		l.unlink(e)
+		entry = e.value
+	}
+	return entry, nil
+}
+
+// ValueAt returns the entry at the position specified by the provided index.
+//
+// The returned error will be non-nil if the provided index is outside the current bounds of the LinkedList
+// (index < 0 || index > LinkedList.Size() - 1).
+func (l *LinkedList[E]) ValueAt(index int) (E, error) {
+	e, err := l.elementAt(index)
+	if err != nil {
+		var entry E
+		return entry, err
+	}
+	return e.value, nil
+}
+
+// Values returns a slice containing the entries in the LinkedList in the iteration order.
+func (l *LinkedList[E]) Values() []E {
+	entries := make([]E, 0, l.size)
+	if l.size > 0 {
+		for e := l.head.next; e != l.tail; e = e.next {
+			entries = append(entries, e.value)
+		}
+	}
+	return entries
+}
+
+// String returns a string representation of the LinkedList in it's current state.
+func (l *LinkedList[E]) String() string {
+	entries := make([]string, 0, l.size)
+	for _, e := range l.Values() {
+		entries = append(entries, fmt.Sprintf("%v", e))
+	}
+	return "[" + strings.Join(entries, ", ") + "]"
+}
+
+// elementAt returns the element at the provided index, walking from whichever end of the LinkedList is nearer.
+func (l *LinkedList[E]) elementAt(index int) (*element[E], error) {
+	if index < 0 || index >= l.size {
+		return nil, fmt.Errorf("list: size = %d, requested index = %d: %w", l.size, index, hold.ErrBoundsOutOfRange)
+	}
+
+	if index < l.size/2 {
+		e := l.head.next
+		for range index {
+			e = e.next
+		}
+		return e, nil
+	}
+
+	e := l.tail.previous
+	for i := l.size - 1; i > index; i-- {
+		e = e.previous
+	}
+	return e, nil
+}
+
+// init lazily creates the head and tail sentinels so that the zero value of a LinkedList is usable.
+func (l *LinkedList[E]) init() {
+	if l.head == nil {
+		l.head = &element[E]{}
+		l.tail = &element[E]{}
+		l.head.next = l.tail
+		l.tail.previous = l.head
+	}
+}
+
+func (l *LinkedList[E]) link(previous *element[E], value E) {
+	e := &element[E]{value: value}
+	e.addAfter(previous)
+	l.size++
+}
+
+func (l *LinkedList[E]) linkBefore(next *element[E], value E) {
+	if next == nil {
+		l.init()
+		next = l.tail
+	}
+	l.link(next.previous, value)
+}
+
+func (l *LinkedList[E]) unlink(e *element[E]) {
+	e.remove()
+	l.size--
+}
@@ -0,0 +1,146 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/transientvariable/hold"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkedList(t *testing.T) {
+	sequences := map[string]func() hold.Sequence[string]{
+		"List":       func() hold.Sequence[string] { return &List[string]{} },
+		"LinkedList": func() hold.Sequence[string] { return &LinkedList[string]{} },
+	}
+
+	for name, newSequence := range sequences {
+		t.Run(name, func(t *testing.T) {
+			seq := newSequence()
+			assert.True(t, seq.IsEmpty())
+
+			v, err := seq.RemoveFirst()
+			assertError(t, err, nil)
+			assert.Equal(t, "", v)
+
+			v, err = seq.RemoveLast()
+			assertError(t, err, nil)
+			assert.Equal(t, "", v)
+
+			assertError(t, seq.Add("luffy", "zoro"), nil)
+			assertError(t, seq.AddFirst("nami"), nil)
+			assertError(t, seq.AddLast("usopp"), nil)
+			assertError(t, seq.AddAt(2, "sanji"), nil)
+			assertError(t, seq.AddAt(5, "chopper"), nil)
+			assertError(t, seq.AddAll(&List[string]{"robin", "zoro"}), nil)
+			assert.Equal(t, []string{"nami", "luffy", "sanji", "zoro", "usopp", "chopper", "robin", "zoro"}, seq.Values())
+			assert.Equal(t, 8, seq.Len())
+			assertError(t, seq.AddAt(-1, "brook"), hold.ErrBoundsOutOfRange)
+
+			i, err := seq.Index("zoro")
+			assertError(t, err, nil)
+			assert.Equal(t, 3, i)
+
+			i, err = seq.IndexFrom("zoro", 4)
+			assertError(t, err, nil)
+			assert.Equal(t, 7, i)
+
+			_, err = seq.IndexFrom("zoro", 9)
+			assertError(t, err, hold.ErrBoundsOutOfRange)
+
+			i, err = seq.Index("brook")
+			assertError(t, err, hold.ErrNotFound)
+			assert.Equal(t, -1, i)
+			assert.True(t, seq.Contains("robin"))
+			assert.False(t, seq.Contains("brook"))
+
+			v, err = seq.ValueAt(6)
+			assertError(t, err, nil)
+			assert.Equal(t, "robin", v)
+
+			_, err = seq.ValueAt(-1)
+			assertError(t, err, hold.ErrBoundsOutOfRange)
+
+			v, err = seq.RemoveAt(2)
+			assertError(t, err, nil)
+			assert.Equal(t, "sanji", v)
+
+			v, err = seq.RemoveFirst()
+			assertError(t, err, nil)
+			assert.Equal(t, "nami", v)
+
+			v, err = seq.RemoveLast()
+			assertError(t, err, nil)
+			assert.Equal(t, "zoro", v)
+
+			r, err := seq.Remove("zoro")
+			assertError(t, err, nil)
+			assert.True(t, r)
+
+			r, err = seq.Remove("brook")
+			assertError(t, err, nil)
+			assert.False(t, r)
+			assert.Equal(t, []string{"luffy", "usopp", "chopper", "robin"}, seq.Values())
+
+			iter := seq.Iterate().(hold.MutableIterator[string])
+			for iter.HasNext() {
+				v, err := iter.Next()
+				assertError(t, err, nil)
+				if v == "usopp" || v == "robin" {
+					assertError(t, iter.Remove(), nil)
+				}
+			}
+			assertError(t, iter.Remove(), hold.ErrNotFound)
+			assert.Equal(t, []string{"luffy", "chopper"}, seq.Values())
+			assert.Equal(t, 2, seq.Len())
+
+			seq.Clear()
+			assert.True(t, seq.IsEmpty())
+			assert.Equal(t, []string{}, seq.Values())
+			assert.False(t, seq.Iterate().HasNext())
+		})
+	}
+}
+
+func TestLinkedList_Iterate(t *testing.T) {
+	list := NewLinkedList("luffy", "zoro", "nami")
+	assert.Equal(t, "[luffy, zoro, nami]", list.String())
+
+	iter := list.Iterate().(*linkedIterator[string])
+	p, err := iter.Peek()
+	assertError(t, err, nil)
+	assert.Equal(t, "luffy", p)
+
+	var values []string
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assertError(t, err, nil)
+		values = append(values, v)
+	}
+	assert.Equal(t, list.Values(), values)
+
+	_, err = iter.Next()
+	assertError(t, err, hold.ErrNoMoreElements)
+
+	_, err = iter.Peek()
+	assertError(t, err, hold.ErrNoMoreElements)
+
+	iter.Reset()
+	v, err := iter.Next()
+	assertError(t, err, nil)
+	assert.Equal(t, "luffy", v)
+}
+
+func BenchmarkList_AddFirst(b *testing.B) {
+	list := List[int]{}
+	for i := range b.N {
+		_ = list.AddFirst(i)
+	}
+}
+
+func BenchmarkLinkedList_AddFirst(b *testing.B) {
+	list := LinkedList[int]{}
+	for i := range b.N {
+		_ = list.AddFirst(i)
+	}
+}
@@ -1,18 +1,36 @@
 package list
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
 	"reflect"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/transientvariable/hold"
 )
 
-var _ hold.Sequence[any] = (*List[any])(nil)
+var (
+	_ hold.Sequence[any]           = (*List[any])(nil)
+	_ hold.MutableIterator[any]    = (*iterator[any])(nil)
+	_ hold.PeekableIterator[any]   = (*iterator[any])(nil)
+	_ hold.ResettableIterator[any] = (*iterator[any])(nil)
+)
 
 type iterator[E comparable] struct {
 	index int
-	list  List[E]
+	last  int
+	list  *List[E]
+}
+
+func newIterator[E comparable](list *List[E]) *iterator[E] {
+	return &iterator[E]{last: -1, list: list}
 }
 
 func (i *iterator[E]) HasNext() bool {
@@ -31,15 +49,54 @@ func (i *iterator[E]) Next() (E, error) {
 	if err != nil {
 		return n, err
 	}
+	i.last = i.index
 	i.index++
 	return n, nil
 }
 
+func (i *iterator[E]) Peek() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("list_iter: %w", hold.ErrNoMoreElements)
+	}
+	return i.list.ValueAt(i.index)
+}
+
+// Remove removes the entry returned by the last call to Next from the List, moving the iterator back so that the
+// following call to Next returns the entry after the removed entry.
+func (i *iterator[E]) Remove() error {
+	if i.last < 0 {
+		return fmt.Errorf("list_iter: %w", hold.ErrNotFound)
+	}
+
+	if _, err := i.list.RemoveAt(i.last); err != nil {
+		return err
+	}
+
+	if i.last < i.index {
+		i.index--
+	}
+	i.last = -1
+	return nil
+}
+
+func (i *iterator[E]) Reset() {
+	i.index = 0
+	i.last = -1
+}
+
 // List is a basic implementation of a Sequence.
 //
 // This implementation does not make any guarantees for concurrent access.
 type List[E comparable] []E
 
+// WithCapacity creates a new, empty List whose backing slice has room for at least the provided number of entries, so
+// that adding up to that many entries does not reallocate. A negative capacity is treated as zero.
+func WithCapacity[E comparable](n int) *List[E] {
+	l := make(List[E], 0, max(n, 0))
+	return &l
+}
+
 // Add inserts the provided entry into the List.
 func (l *List[E]) Add(entry ...E) error {
 	*l = append(*l, entry...)
@@ -56,16 +113,14 @@ func (l *List[E]) AddAll(collection hold.Collection[E]) error {
 
 // AddAt inserts the provided entry into the List specified by index.
 //
-// The position of the entries that were at positions index to List.Size() - 1 increase by one. The returned error will
-// be non-nil if the provided index is outside the current bounds of the List (index < 0 || index > List.Size() - 1).
+// The position of the entries that were at positions index to List.Size() - 1 increase by one, and an index equal to
+// List.Size() appends the entry. The returned error will be non-nil if the provided index is outside the current bounds
+// of the List (index < 0 || index > List.Size()).
 func (l *List[E]) AddAt(index int, entry E) error {
 	if err := l.checkBounds(index); err != nil {
 		return err
 	}
-	var e E
-	*l = append(*l, e)
-	copy((*l)[index+1:], (*l)[index:])
-	(*l)[index] = entry
+	*l = slices.Insert(*l, index, entry)
 	return nil
 }
 
@@ -82,11 +137,39 @@ func (l *List[E]) AddLast(value E) error {
 	return l.Add(value)
 }
 
+// BinarySearch searches the List, which must be sorted in ascending order as defined by the provided comparator, for
+// the provided value.
+//
+// The comparator should return a negative number when a < b, a positive number when a > b, and zero when a == b. If
+// the value is found, its position and true are returned, otherwise the position at which the value would be inserted
+// to keep the List sorted and false are returned.
+func (l *List[E]) BinarySearch(value E, cmp func(a, b E) int) (int, bool) {
+	return slices.BinarySearchFunc(*l, value, cmp)
+}
+
 // Clear removes all entries from the List.
 func (l *List[E]) Clear() {
 	*l = List[E]{}
 }
 
+// Clone returns a shallow copy of the List backed by a newly allocated slice, so that modifying or appending to the
+// returned List never affects the List and vice versa.
+func (l *List[E]) Clone() *List[E] {
+	c := make(List[E], l.Len())
+	copy(c, *l)
+	return &c
+}
+
+// Compact reallocates the backing slice of the List to hold exactly its current entries, releasing any excess capacity
+// retained after removing entries.
+func (l *List[E]) Compact() {
+	if cap(*l) > len(*l) {
+		c := make(List[E], len(*l))
+		copy(c, *l)
+		*l = c
+	}
+}
+
 // Contains returns true if an entry equivalent to the provided value exists in the List, otherwise false is
 // returned.
 func (l *List[E]) Contains(value E) bool {
@@ -96,6 +179,174 @@ func (l *List[E]) Contains(value E) bool {
 	return false
 }
 
+// ContainsAll returns true if an entry equivalent to each of the provided values exists in the List, otherwise false
+// is returned. The search stops at the first value that is not found, and true is returned if no values are provided.
+func (l *List[E]) ContainsAll(values ...E) bool {
+	for _, v := range values {
+		if !l.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny returns true if an entry equivalent to at least one of the provided values exists in the List, otherwise
+// false is returned. The search stops at the first value that is found, and false is returned if no values are
+// provided.
+func (l *List[E]) ContainsAny(values ...E) bool {
+	for _, v := range values {
+		if l.Contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsFunc returns true if the List contains an entry for which the provided predicate returns true, otherwise
+// false is returned.
+func (l *List[E]) ContainsFunc(pred func(E) bool) bool {
+	_, err := l.IndexFunc(pred)
+	return err == nil
+}
+
+// Count returns the number of entries in the List that are equivalent to the provided value.
+func (l *List[E]) Count(value E) int {
+	return len(l.IndicesOf(value))
+}
+
+// Distinct removes duplicate entries from the List in place.
+//
+// The first occurrence of each entry is kept, and the relative order of the remaining entries is preserved. Entries are
+// compared using reflect.DeepEqual, like Contains and Count. Entries of a basic type, such as an int or a string, are
+// tracked in a map, while entries of other types are compared with each entry kept so far.
+func (l *List[E]) Distinct() {
+	var n int
+	if isBasic[E]() {
+		seen := make(map[E]struct{}, l.Len())
+		for _, e := range *l {
+			if _, ok := seen[e]; ok {
+				continue
+			}
+			seen[e] = struct{}{}
+			(*l)[n] = e
+			n++
+		}
+	} else {
+		for _, e := range *l {
+			if slices.ContainsFunc((*l)[:n], func(k E) bool { return reflect.DeepEqual(k, e) }) {
+				continue
+			}
+			(*l)[n] = e
+			n++
+		}
+	}
+
+	clear((*l)[n:])
+	*l = (*l)[:n]
+}
+
+// DistinctCopy returns a new List containing the entries of the List with duplicates removed. The List itself is not
+// modified.
+//
+// The first occurrence of each entry is kept, and the relative order of the remaining entries is preserved.
+func (l *List[E]) DistinctCopy() *List[E] {
+	c := l.Clone()
+	c.Distinct()
+	return c
+}
+
+// Equals returns true if the provided List has the same number of entries as the List, and the entries are equivalent
+// in iteration order, otherwise false is returned.
+//
+// Entries are compared using reflect.DeepEqual, and a nil List is equal to an empty one.
+func (l *List[E]) Equals(other *List[E]) bool {
+	var a, b List[E]
+	if l != nil {
+		a = *l
+	}
+
+	if other != nil {
+		b = *other
+	}
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !reflect.DeepEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ForEach calls the provided function for each entry of the List in iteration order.
+//
+// Iteration stops at the first non-nil error returned by the function, and that error is returned.
+func (l *List[E]) ForEach(fn func(E) error) error {
+	return hold.ForEach[E](l, fn)
+}
+
+// GobDecode replaces the contents of the List with the entries decoded from data produced by GobEncode.
+//
+// Entries are decoded using encoding/gob, so concrete types held by entries of an interface type must be registered
+// with gob.Register by the caller.
+func (l *List[E]) GobDecode(data []byte) error {
+	var entries []E
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries); err != nil {
+		return fmt.Errorf("list: %w", err)
+	}
+
+	l.Clear()
+	return l.Add(entries...)
+}
+
+// GobEncode returns the encoding/gob encoding of the entries in the List in iteration order, so that the List can be
+// passed to gob.Encoder.Encode, such as when it is transferred using net/rpc.
+//
+// Entries are encoded using encoding/gob, so concrete types held by entries of an interface type must be registered
+// with gob.Register by the caller.
+func (l List[E]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode([]E(l)); err != nil {
+		return nil, fmt.Errorf("list: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Grow increases the capacity of the List, if necessary, to guarantee room for the provided number of additional
+// entries, mirroring slices.Grow. After Grow(n), at least n entries can be added to the List without reallocating. A
+// non-positive n has no effect.
+func (l *List[E]) Grow(n int) {
+	if n > 0 {
+		*l = slices.Grow(*l, n)
+	}
+}
+
+// Hash returns a 64-bit FNV-1a hash of the entries in the List, computed over the string representation of each entry
+// in iteration order, as formatted by the %v verb, so that lists with the same content produce the same hash, while
+// reordering the entries changes it.
+//
+// The hash only depends on what %v prints for each entry, so lists that are equal according to Equals can produce
+// different hashes if their entries hold pointers nested within other values, which %v prints as addresses. Distinct
+// entries may also share a string representation, so lists with the same hash need not be equal.
+func (l *List[E]) Hash() uint64 {
+	h := fnv.New64a()
+	if l != nil {
+		var buf []byte
+		for _, e := range *l {
+			// Each entry is prefixed with its length, so that ["ab", "c"] and ["a", "bc"] differ even if an entry
+			// contains a separator.
+			s := fmt.Sprintf("%v", e)
+			buf = binary.AppendUvarint(buf[:0], uint64(len(s)))
+			_, _ = h.Write(buf)
+			_, _ = h.Write([]byte(s))
+		}
+	}
+	return h.Sum64()
+}
+
 // Index returns the position of the first occurrence (if any) of an entry equivalent to the provided entry.
 //
 // The returned error will be non-nil if provided entry is not found in the List, and the returned index will be equal
@@ -108,14 +359,84 @@ func (l *List[E]) Index(value E) (int, error) {
 	return i, nil
 }
 
+// IndexFunc returns the position of the first entry (if any) for which the provided predicate returns true.
+//
+// The returned error will be non-nil if no entry in the List satisfies the predicate, and the returned index will be
+// -1.
+func (l *List[E]) IndexFunc(pred func(E) bool) (int, error) {
+	for i, v := range *l {
+		if pred(v) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("list: %w", hold.ErrNotFound)
+}
+
+// IndexFrom returns the position of the first occurrence (if any) of an entry equivalent to the provided entry at or
+// after the provided start position.
+//
+// The returned error will be non-nil if the provided start position is outside the current bounds of the List
+// (start < 0 || start > List.Size()), or if no such entry is found, and the returned index will be -1.
+func (l *List[E]) IndexFrom(value E, start int) (int, error) {
+	if err := l.checkBounds(start); err != nil {
+		return -1, err
+	}
+
+	for i := start; i < l.Len(); i++ {
+		if reflect.DeepEqual((*l)[i], value) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("list: %w", hold.ErrNotFound)
+}
+
+// IndexLast returns the position of the last occurrence (if any) of an entry equivalent to the provided entry.
+//
+// The returned error will be non-nil if provided entry is not found in the List, and the returned index will be -1.
+func (l *List[E]) IndexLast(value E) (int, error) {
+	for i := l.Len() - 1; i >= 0; i-- {
+		if reflect.DeepEqual((*l)[i], value) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("list: %w", hold.ErrNotFound)
+}
+
+// IndicesOf returns the positions of all entries in the List that are equivalent to the provided value in ascending
+// order.
+//
+// If the List does not contain an entry equivalent to the provided value, the returned slice will be empty.
+func (l *List[E]) IndicesOf(value E) []int {
+	indices := make([]int, 0)
+	for i, v := range *l {
+		if reflect.DeepEqual(v, value) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// InsertSorted inserts the provided value into the List, which must be sorted in ascending order as defined by the
+// provided comparator, at the position that keeps the List sorted.
+//
+// If the List already contains entries equal to the value, the value is inserted after them.
+func (l *List[E]) InsertSorted(value E, cmp func(a, b E) int) {
+	i := sort.Search(l.Len(), func(i int) bool {
+		return cmp((*l)[i], value) > 0
+	})
+	*l = slices.Insert(*l, i, value)
+}
+
 // IsEmpty returns true if the List contains no entries, otherwise false is returned.
 func (l *List[E]) IsEmpty() bool {
 	return l.Len() == 0
 }
 
 // Iterate returns the collection.Iterator for the List.
+//
+// The returned iterator also implements hold.MutableIterator, hold.PeekableIterator and hold.ResettableIterator.
 func (l *List[E]) Iterate() hold.Iterator[E] {
-	return &iterator[E]{list: *l}
+	return newIterator(l)
 }
 
 // Len returns the number of entries in the List.
@@ -123,6 +444,54 @@ func (l *List[E]) Len() int {
 	return len(*l)
 }
 
+// MarshalJSON returns the JSON encoding of the List as an array of its entries in iteration order.
+//
+// A nil or empty List is encoded as an empty array rather than null.
+func (l List[E]) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		l = List[E]{}
+	}
+	return json.Marshal([]E(l))
+}
+
+// Move removes the entry at the provided from index and reinserts it at the provided to index.
+//
+// The entries between the two positions are shifted by one towards from. The returned error will be non-nil if either
+// of the provided indices is outside the current bounds of the List (index < 0 || index > List.Size() - 1).
+func (l *List[E]) Move(from int, to int) error {
+	if err := l.checkIndex(from); err != nil {
+		return err
+	}
+
+	if err := l.checkIndex(to); err != nil {
+		return err
+	}
+
+	entry := (*l)[from]
+	if from < to {
+		copy((*l)[from:to], (*l)[from+1:to+1])
+	} else {
+		copy((*l)[to+1:from+1], (*l)[to:from])
+	}
+	(*l)[to] = entry
+	return nil
+}
+
+// Partition returns two new Lists, the first containing the entries of the List for which the provided predicate returns
+// true, and the second containing the remaining entries. Entries keep their relative order within each of the returned
+// Lists, and the List itself is not modified.
+func (l *List[E]) Partition(pred func(E) bool) (matched *List[E], rest *List[E]) {
+	matched, rest = &List[E]{}, &List[E]{}
+	for _, e := range *l {
+		if pred(e) {
+			*matched = append(*matched, e)
+		} else {
+			*rest = append(*rest, e)
+		}
+	}
+	return matched, rest
+}
+
 // Remove removes the first occurrence (if any) of an entry equivalent to the provided value.
 //
 // If an entry was removed, the return value will be true, otherwise false will be returned.
@@ -138,6 +507,16 @@ func (l *List[E]) Remove(value E) (bool, error) {
 	return false, nil
 }
 
+// RemoveAll removes all entries equivalent to the provided value from the List and returns the number of entries that
+// were removed.
+//
+// The relative order of the remaining entries is preserved.
+func (l *List[E]) RemoveAll(value E) int {
+	return l.RemoveIf(func(e E) bool {
+		return reflect.DeepEqual(e, value)
+	})
+}
+
 // RemoveAt removes the entry at the provided index from the List and returns it.
 //
 // The positions of the entries original positions index + 1 to List.Size() - 1 are decremented by 1. The returned error
@@ -167,6 +546,25 @@ func (l *List[E]) RemoveFirst() (E, error) {
 	return entry, nil
 }
 
+// RemoveIf removes all entries from the List for which the provided predicate returns true and returns the number of
+// entries that were removed.
+//
+// The relative order of the remaining entries is preserved.
+func (l *List[E]) RemoveIf(pred func(E) bool) int {
+	n := 0
+	for _, e := range *l {
+		if !pred(e) {
+			(*l)[n] = e
+			n++
+		}
+	}
+
+	removed := l.Len() - n
+	clear((*l)[n:])
+	*l = (*l)[:n]
+	return removed
+}
+
 // RemoveLast removes the entry at the end (index == List.Size() - 1) of the List and returns it.
 //
 // If the List is empty (List.Size() == 0), the return value will be nil.
@@ -182,12 +580,127 @@ func (l *List[E]) RemoveLast() (E, error) {
 	return entry, nil
 }
 
+// Rotate rotates the entries of the List left by the provided number of positions, so that the entry at index n becomes
+// the first entry. A negative n rotates the entries right instead.
+//
+// Rotating by a multiple of the length of the List, or rotating an empty List, has no effect.
+func (l *List[E]) Rotate(n int) {
+	size := l.Len()
+	if size == 0 {
+		return
+	}
+
+	n %= size
+	if n < 0 {
+		n += size
+	}
+
+	if n == 0 {
+		return
+	}
+	slices.Reverse((*l)[:n])
+	slices.Reverse((*l)[n:])
+	slices.Reverse(*l)
+}
+
+// Shuffle randomly reorders the entries of the List in place using the Fisher-Yates algorithm, drawing from the
+// provided source of randomness so that a seeded source produces a reproducible permutation.
+//
+// If the provided source is nil, the default source from math/rand/v2 is used.
+func (l *List[E]) Shuffle(r *rand.Rand) {
+	swap := func(i, j int) {
+		(*l)[i], (*l)[j] = (*l)[j], (*l)[i]
+	}
+
+	if r == nil {
+		rand.Shuffle(l.Len(), swap)
+		return
+	}
+	r.Shuffle(l.Len(), swap)
+}
+
+// Shuffled returns a new List containing the entries of the List randomly reordered as described by Shuffle. The List
+// itself is not modified.
+func (l *List[E]) Shuffled(r *rand.Rand) *List[E] {
+	c := l.Clone()
+	c.Shuffle(r)
+	return c
+}
+
+// Sort sorts the entries of the List in place in ascending order as defined by the provided comparator.
+//
+// The comparator should return a negative number when a < b, a positive number when a > b, and zero when a == b. The
+// sort is stable: entries that compare as equal keep their original relative order.
+func (l *List[E]) Sort(cmp func(a, b E) int) {
+	sort.SliceStable(*l, func(i, j int) bool {
+		return cmp((*l)[i], (*l)[j]) < 0
+	})
+}
+
+// SortedCopy returns a new List containing the entries of the List sorted in ascending order as defined by the
+// provided comparator. The List itself is not modified.
+//
+// The sort is stable: entries that compare as equal keep their original relative order.
+func (l *List[E]) SortedCopy(cmp func(a, b E) int) *List[E] {
+	c := l.Clone()
+	c.Sort(cmp)
+	return c
+}
+
+// SubList returns a new List containing the entries of the List between the provided from index, inclusive, and to
+// index, exclusive.
+//
+// The returned List is a copy, so changes to it are not reflected in the List and vice versa. The returned error will
+// be non-nil if the provided indices are outside the current bounds of the List (from < 0 || to > List.Size()) or if
+// from > to.
+func (l *List[E]) SubList(from int, to int) (*List[E], error) {
+	if from < 0 || to > l.Len() || from > to {
+		return nil, fmt.Errorf("list: size = %d, requested range = [%d, %d): %w",
+			l.Len(),
+			from,
+			to,
+			hold.ErrBoundsOutOfRange)
+	}
+
+	s := make(List[E], to-from)
+	copy(s, (*l)[from:to])
+	return &s, nil
+}
+
+// Swap exchanges the entries at the provided indices.
+//
+// The returned error will be non-nil if either of the provided indices is outside the current bounds of the List
+// (index < 0 || index > List.Size() - 1).
+func (l *List[E]) Swap(i int, j int) error {
+	if err := l.checkIndex(i); err != nil {
+		return err
+	}
+
+	if err := l.checkIndex(j); err != nil {
+		return err
+	}
+
+	(*l)[i], (*l)[j] = (*l)[j], (*l)[i]
+	return nil
+}
+
+// UnmarshalJSON replaces the contents of the List with the entries decoded from the provided JSON array.
+func (l *List[E]) UnmarshalJSON(data []byte) error {
+	var entries []E
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("list: %w", err)
+	}
+
+	l.Clear()
+	return l.Add(entries...)
+}
+
 // ValueAt returns the entry at the position specified by the provided index.
 //
 // The returned error will be non-nil if the provided index is outside the current bounds of the List
 // (index < 0 || index > List.Size() - 1).
 func (l *List[E]) ValueAt(index int) (E, error) {
-	if err := l.checkBounds(index); err != nil {
+	if err := l.checkIndex(index); err != nil {
 		var e E
 		return e, err
 	}
@@ -214,6 +727,8 @@ func (l *List[E]) String() string {
 	return "[" + strings.Join(entries, ", ") + "]"
 }
 
+// checkBounds returns an error if the provided index is not a position at which an entry can be inserted into the List,
+// which includes the position after the last entry.
 func (l *List[E]) checkBounds(index int) error {
 	if index < 0 || index > l.Len() {
 		return fmt.Errorf("list: size = %d, requested index = %d: %w", l.Len(), index, hold.ErrBoundsOutOfRange)
@@ -221,11 +736,30 @@ func (l *List[E]) checkBounds(index int) error {
 	return nil
 }
 
+// checkIndex is like checkBounds, but only accepts indices that refer to an existing entry.
+func (l *List[E]) checkIndex(index int) error {
+	if index < 0 || index >= l.Len() {
+		return fmt.Errorf("list: size = %d, requested index = %d: %w", l.Len(), index, hold.ErrBoundsOutOfRange)
+	}
+	return nil
+}
+
 func (l *List[E]) findFirst(entry E) (int, error) {
-	for i, v := range *l {
-		if reflect.DeepEqual(v, entry) {
-			return i, nil
-		}
+	return l.IndexFunc(func(v E) bool {
+		return reflect.DeepEqual(v, entry)
+	})
+}
+
+// isBasic returns true if E is a boolean, numeric or string type, for which == agrees with reflect.DeepEqual, so that
+// entries can be compared by a map key.
+func isBasic[E comparable]() bool {
+	switch reflect.TypeFor[E]().Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.String:
+		return true
 	}
-	return -1, fmt.Errorf("list: %w", hold.ErrNotFound)
+	return false
 }
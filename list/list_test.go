@@ -1,8 +1,15 @@
 package list
 
 import (
+	"bytes"
+	"cmp"
+	"encoding/gob"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
 	"testing"
 
 	"github.com/transientvariable/hold"
@@ -58,7 +65,7 @@ func TestAdd(t *testing.T) {
 		assertError(t, err, nil)
 		assertSize(t, list, 7)
 		assertContains(t, &list, entry, true)
-		assertIndex(t, list, entry, 0)
+		assertIndex(t, list, entry, 6)
 	})
 
 	t.Run("AddAt", func(t *testing.T) {
@@ -74,6 +81,42 @@ func TestAdd(t *testing.T) {
 		assertIndex(t, list, entry, index)
 	})
 
+	t.Run("AddAtBounds", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			index    int
+			expected List[int]
+		}{
+			{name: "First", index: 0, expected: List[int]{9, 0, 1, 2}},
+			{name: "Last", index: 2, expected: List[int]{0, 1, 9, 2}},
+			{name: "Append", index: 3, expected: List[int]{0, 1, 2, 9}},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				list := List[int]{0, 1, 2}
+				assertError(t, list.AddAt(tc.index, 9), nil)
+				assert.Equal(t, tc.expected, list)
+			})
+		}
+
+		list := List[int]{0, 1, 2}
+		assertError(t, list.AddAt(4, 9), hold.ErrBoundsOutOfRange)
+		assertError(t, list.AddAt(-1, 9), hold.ErrBoundsOutOfRange)
+		assert.Equal(t, List[int]{0, 1, 2}, list)
+
+		empty := List[int]{}
+		assertError(t, empty.AddAt(0, 9), nil)
+		assert.Equal(t, List[int]{9}, empty)
+
+		_, err := list.ValueAt(3)
+		assertError(t, err, hold.ErrBoundsOutOfRange)
+
+		_, err = list.RemoveAt(3)
+		assertError(t, err, hold.ErrBoundsOutOfRange)
+		assert.Equal(t, List[int]{0, 1, 2}, list)
+	})
+
 	t.Run("AddAll", func(t *testing.T) {
 		list := List[entry]{}
 		list = append(list, entries...)
@@ -199,6 +242,817 @@ func TestRemove(t *testing.T) {
 	})
 }
 
+func TestCount(t *testing.T) {
+	list := List[string]{"luffy", "zoro", "luffy", "nami", "luffy"}
+
+	tests := []struct {
+		value   string
+		indices []int
+	}{
+		{value: "sanji", indices: []int{}},
+		{value: "zoro", indices: []int{1}},
+		{value: "luffy", indices: []int{0, 2, 4}},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, len(tc.indices), list.Count(tc.value), "value: %s", tc.value)
+		assert.Equal(t, tc.indices, list.IndicesOf(tc.value), "value: %s", tc.value)
+	}
+
+	empty := List[string]{}
+	assert.Equal(t, 0, empty.Count("luffy"))
+	assert.Empty(t, empty.IndicesOf("luffy"))
+}
+
+func TestContainsAll(t *testing.T) {
+	list := List[string]{"luffy", "zoro", "nami"}
+
+	tests := []struct {
+		name   string
+		values []string
+		all    bool
+		any    bool
+	}{
+		{name: "AllPresent", values: []string{"nami", "luffy"}, all: true, any: true},
+		{name: "SomePresent", values: []string{"zoro", "sanji"}, all: false, any: true},
+		{name: "NonePresent", values: []string{"sanji", "usopp"}, all: false, any: false},
+		{name: "NoValues", values: nil, all: true, any: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.all, list.ContainsAll(tc.values...))
+			assert.Equal(t, tc.any, list.ContainsAny(tc.values...))
+		})
+	}
+
+	empty := List[string]{}
+	assert.False(t, empty.ContainsAll("luffy"))
+	assert.False(t, empty.ContainsAny("luffy"))
+}
+
+func TestIndexFunc(t *testing.T) {
+	a, b := 0.1, 0.2
+	list := List[float64]{a, a + b, 1.5}
+
+	approx := func(value float64) func(float64) bool {
+		return func(e float64) bool {
+			return math.Abs(e-value) < 1e-9
+		}
+	}
+
+	assert.False(t, list.Contains(0.3))
+	assert.True(t, list.ContainsFunc(approx(0.3)))
+	assert.False(t, list.ContainsFunc(approx(0.4)))
+
+	i, err := list.IndexFunc(approx(0.3))
+	assertError(t, err, nil)
+	assert.Equal(t, 1, i)
+
+	i, err = list.IndexFunc(func(e float64) bool { return e > 1 })
+	assertError(t, err, nil)
+	assert.Equal(t, 2, i)
+
+	i, err = list.IndexFunc(approx(0.4))
+	assertError(t, err, hold.ErrNotFound)
+	assert.Equal(t, -1, i)
+
+	type player struct {
+		name string
+	}
+
+	luffy := &player{name: "luffy"}
+	players := List[*player]{{name: "luffy"}, luffy}
+	i, err = players.IndexFunc(func(p *player) bool { return p == luffy })
+	assertError(t, err, nil)
+	assert.Equal(t, 1, i)
+}
+
+func TestIndexFrom(t *testing.T) {
+	list := List[string]{"luffy", "zoro", "luffy", "nami", "luffy", "zoro"}
+
+	var indices []int
+	for i, err := list.IndexFrom("luffy", 0); err == nil; i, err = list.IndexFrom("luffy", i+1) {
+		indices = append(indices, i)
+	}
+	assert.Equal(t, []int{0, 2, 4}, indices)
+
+	i, err := list.IndexFrom("luffy", 1)
+	assertError(t, err, nil)
+	assert.Equal(t, 2, i)
+
+	i, err = list.IndexFrom("luffy", 3)
+	assertError(t, err, nil)
+	assert.Equal(t, 4, i)
+
+	i, err = list.IndexFrom("nami", 4)
+	assertError(t, err, hold.ErrNotFound)
+	assert.Equal(t, -1, i)
+
+	i, err = list.IndexFrom("zoro", list.Len())
+	assertError(t, err, hold.ErrNotFound)
+	assert.Equal(t, -1, i)
+
+	for _, start := range []int{-1, list.Len() + 1} {
+		i, err = list.IndexFrom("luffy", start)
+		assertError(t, err, hold.ErrBoundsOutOfRange)
+		assert.Equal(t, -1, i)
+	}
+}
+
+func TestIndexLast(t *testing.T) {
+	list := List[string]{"luffy", "zoro", "luffy", "nami"}
+	assertError(t, list.AddLast("zoro"), nil)
+
+	i, err := list.Index("zoro")
+	assertError(t, err, nil)
+	assert.Equal(t, 1, i)
+
+	i, err = list.IndexLast("zoro")
+	assertError(t, err, nil)
+	assert.Equal(t, 4, i)
+
+	i, err = list.IndexLast("luffy")
+	assertError(t, err, nil)
+	assert.Equal(t, 2, i)
+
+	i, err = list.IndexLast("nami")
+	assertError(t, err, nil)
+	assert.Equal(t, 3, i)
+
+	i, err = list.IndexLast("usopp")
+	assertError(t, err, hold.ErrNotFound)
+	assert.Equal(t, -1, i)
+
+	empty := List[string]{}
+	_, err = empty.IndexLast("luffy")
+	assertError(t, err, hold.ErrNotFound)
+}
+
+func TestForEach(t *testing.T) {
+	list := List[string]{"luffy", "zoro", "nami", "sanji"}
+	errStop := errors.New("stop")
+
+	var visited []string
+	err := list.ForEach(func(e string) error {
+		visited = append(visited, e)
+		if e == "zoro" {
+			return errStop
+		}
+		return nil
+	})
+	assertError(t, err, errStop)
+	assert.Equal(t, []string{"luffy", "zoro"}, visited)
+}
+
+func TestClone(t *testing.T) {
+	list := make(List[int], 3, 10)
+	copy(list, []int{1, 2, 3})
+
+	clone := list.Clone()
+	assert.Equal(t, list, *clone)
+
+	assertError(t, clone.Add(4), nil)
+	assertError(t, list.Add(5), nil)
+	(*clone)[0] = 9
+
+	assert.Equal(t, List[int]{1, 2, 3, 5}, list)
+	assert.Equal(t, List[int]{9, 2, 3, 4}, *clone)
+
+	empty := List[int]{}
+	assert.True(t, empty.Clone().IsEmpty())
+}
+
+func TestEqualsHash(t *testing.T) {
+	a := List[string]{"luffy", "zoro", "nami"}
+	b := List[string]{"luffy", "zoro", "nami"}
+	assert.True(t, a.Equals(&b))
+	assert.Equal(t, a.Hash(), b.Hash())
+
+	reordered := List[string]{"zoro", "luffy", "nami"}
+	assert.False(t, a.Equals(&reordered))
+	assert.NotEqual(t, a.Hash(), reordered.Hash())
+
+	shorter := List[string]{"luffy", "zoro"}
+	assert.False(t, a.Equals(&shorter))
+	assert.False(t, shorter.Equals(&a))
+	assert.NotEqual(t, a.Hash(), shorter.Hash())
+
+	joined := List[string]{"luf", "fyzoro", "nami"}
+	assert.NotEqual(t, a.Hash(), joined.Hash())
+
+	// Entries containing NUL cannot be mistaken for the boundary between entries.
+	separated := List[string]{"a\x00", "b"}
+	merged := List[string]{"a", "\x00b"}
+	assert.NotEqual(t, separated.Hash(), merged.Hash())
+
+	var nilList *List[string]
+	empty := List[string]{}
+	assert.True(t, empty.Equals(nilList))
+	assert.True(t, nilList.Equals(&empty))
+	assert.False(t, nilList.Equals(&a))
+	assert.Equal(t, empty.Hash(), nilList.Hash())
+
+	// Lists with the same content collapse to a single key when their hashes are used to dedupe a list of lists.
+	seen := make(map[uint64]*List[string])
+	for _, l := range []*List[string]{&a, &reordered, &b, &shorter} {
+		if _, ok := seen[l.Hash()]; !ok {
+			seen[l.Hash()] = l
+		}
+	}
+	assert.Len(t, seen, 3)
+}
+
+func TestGrow(t *testing.T) {
+	list := WithCapacity[int](8)
+	assert.True(t, list.IsEmpty())
+	assert.Equal(t, 8, cap(*list))
+
+	assertError(t, list.Add(1, 2, 3), nil)
+	list.Grow(100)
+	assert.GreaterOrEqual(t, cap(*list), 103)
+	assert.Equal(t, List[int]{1, 2, 3}, *list)
+
+	c := cap(*list)
+	list.Grow(0)
+	list.Grow(-1)
+	assert.Equal(t, c, cap(*list))
+
+	assert.Equal(t, 0, cap(*WithCapacity[int](-1)))
+}
+
+func TestCompact(t *testing.T) {
+	list := WithCapacity[int](100)
+	for i := range 100 {
+		assertError(t, list.Add(i), nil)
+	}
+	assert.Equal(t, 50, list.RemoveIf(func(e int) bool { return e%2 == 0 }))
+	assert.Equal(t, 100, cap(*list))
+
+	values := list.Values()
+	list.Compact()
+	assert.Equal(t, list.Len(), cap(*list))
+	assert.Equal(t, values, list.Values())
+
+	empty := List[int]{}
+	empty.Compact()
+	assert.True(t, empty.IsEmpty())
+}
+
+func TestIterate(t *testing.T) {
+	list := List[string]{"luffy", "zoro", "nami"}
+
+	iter, ok := list.Iterate().(hold.ResettableIterator[string])
+	assert.True(t, ok)
+
+	var first []string
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assertError(t, err, nil)
+		first = append(first, v)
+	}
+
+	_, err := iter.Next()
+	assertError(t, err, hold.ErrNoMoreElements)
+
+	iter.Reset()
+
+	var second []string
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assertError(t, err, nil)
+		second = append(second, v)
+	}
+	assert.Equal(t, first, second)
+	assert.Equal(t, list.Values(), second)
+}
+
+func TestIterate_Peek(t *testing.T) {
+	list := List[string]{"luffy", "zoro"}
+
+	iter, ok := list.Iterate().(hold.PeekableIterator[string])
+	assert.True(t, ok)
+
+	for _, expected := range list {
+		v, err := iter.Peek()
+		assertError(t, err, nil)
+		assert.Equal(t, expected, v)
+
+		v, err = iter.Peek()
+		assertError(t, err, nil)
+		assert.Equal(t, expected, v)
+
+		v, err = iter.Next()
+		assertError(t, err, nil)
+		assert.Equal(t, expected, v)
+	}
+
+	_, err := iter.Peek()
+	assertError(t, err, hold.ErrNoMoreElements)
+	assert.False(t, iter.HasNext())
+}
+
+func TestIterate_Remove(t *testing.T) {
+	list := List[int]{0, 1, 2, 3, 4, 5, 6}
+
+	iter, ok := list.Iterate().(hold.MutableIterator[int])
+	assert.True(t, ok)
+	assertError(t, iter.Remove(), hold.ErrNotFound)
+
+	var visited []int
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assertError(t, err, nil)
+		visited = append(visited, v)
+
+		if v%2 == 0 {
+			assertError(t, iter.Remove(), nil)
+			assertError(t, iter.Remove(), hold.ErrNotFound)
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, visited)
+	assert.Equal(t, List[int]{1, 3, 5}, list)
+
+	iter = list.Iterate().(hold.MutableIterator[int])
+	for iter.HasNext() {
+		_, err := iter.Next()
+		assertError(t, err, nil)
+		assertError(t, iter.Remove(), nil)
+	}
+	assert.True(t, list.IsEmpty())
+}
+
+func TestRemoveIf(t *testing.T) {
+	t.Run("RemoveAll", func(t *testing.T) {
+		list := List[string]{"luffy", "zoro", "nami", "zoro", "sanji"}
+		assert.Equal(t, 2, list.RemoveAll("zoro"))
+		assert.Equal(t, List[string]{"luffy", "nami", "sanji"}, list)
+
+		assert.Equal(t, 0, list.RemoveAll("zoro"))
+		assert.Equal(t, List[string]{"luffy", "nami", "sanji"}, list)
+	})
+
+	t.Run("Middle", func(t *testing.T) {
+		list := List[int]{1, 2, 3, 4, 5, 6}
+		assert.Equal(t, 2, list.RemoveIf(func(e int) bool { return e == 3 || e == 4 }))
+		assert.Equal(t, List[int]{1, 2, 5, 6}, list)
+	})
+
+	t.Run("All", func(t *testing.T) {
+		list := List[int]{1, 2, 3}
+		assert.Equal(t, 3, list.RemoveIf(func(e int) bool { return true }))
+		assert.True(t, list.IsEmpty())
+
+		list = List[int]{7, 7, 7}
+		assert.Equal(t, 3, list.RemoveAll(7))
+		assert.True(t, list.IsEmpty())
+	})
+
+	t.Run("None", func(t *testing.T) {
+		list := List[int]{1, 2, 3}
+		assert.Equal(t, 0, list.RemoveIf(func(e int) bool { return e > 3 }))
+		assert.Equal(t, List[int]{1, 2, 3}, list)
+	})
+}
+
+func TestPartition(t *testing.T) {
+	even := func(e int) bool { return e%2 == 0 }
+
+	t.Run("Mixed", func(t *testing.T) {
+		list := List[int]{1, 2, 3, 4, 5, 6, 7}
+		matched, rest := list.Partition(even)
+		assert.Equal(t, List[int]{2, 4, 6}, *matched)
+		assert.Equal(t, List[int]{1, 3, 5, 7}, *rest)
+		assert.Equal(t, List[int]{1, 2, 3, 4, 5, 6, 7}, list)
+
+		// Merging the partitions back in the order of the predicate results reconstructs the List.
+		var merged List[int]
+		for _, e := range list {
+			if even(e) {
+				merged = append(merged, (*matched)[0])
+				*matched = (*matched)[1:]
+			} else {
+				merged = append(merged, (*rest)[0])
+				*rest = (*rest)[1:]
+			}
+		}
+		assert.Equal(t, list, merged)
+	})
+
+	t.Run("All", func(t *testing.T) {
+		list := List[int]{2, 4, 6}
+		matched, rest := list.Partition(even)
+		assert.Equal(t, List[int]{2, 4, 6}, *matched)
+		assert.True(t, rest.IsEmpty())
+	})
+
+	t.Run("None", func(t *testing.T) {
+		list := List[int]{1, 3, 5}
+		matched, rest := list.Partition(even)
+		assert.True(t, matched.IsEmpty())
+		assert.Equal(t, List[int]{1, 3, 5}, *rest)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		list := List[int]{}
+		matched, rest := list.Partition(even)
+		assert.True(t, matched.IsEmpty())
+		assert.True(t, rest.IsEmpty())
+	})
+}
+
+func TestSwap(t *testing.T) {
+	list := List[string]{"luffy", "zoro", "nami"}
+
+	assertError(t, list.Swap(0, 2), nil)
+	assert.Equal(t, List[string]{"nami", "zoro", "luffy"}, list)
+
+	assertError(t, list.Swap(1, 1), nil)
+	assert.Equal(t, List[string]{"nami", "zoro", "luffy"}, list)
+
+	for _, p := range [][2]int{{-1, 0}, {0, 3}, {3, 0}} {
+		assertError(t, list.Swap(p[0], p[1]), hold.ErrBoundsOutOfRange)
+	}
+	assert.Equal(t, List[string]{"nami", "zoro", "luffy"}, list)
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     int
+		to       int
+		expected List[int]
+	}{
+		{name: "Forward", from: 1, to: 4, expected: List[int]{0, 2, 3, 4, 1, 5}},
+		{name: "Backward", from: 4, to: 1, expected: List[int]{0, 4, 1, 2, 3, 5}},
+		{name: "ToEnd", from: 0, to: 5, expected: List[int]{1, 2, 3, 4, 5, 0}},
+		{name: "ToFront", from: 5, to: 0, expected: List[int]{5, 0, 1, 2, 3, 4}},
+		{name: "NoOp", from: 2, to: 2, expected: List[int]{0, 1, 2, 3, 4, 5}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			list := List[int]{0, 1, 2, 3, 4, 5}
+			assertError(t, list.Move(tc.from, tc.to), nil)
+			assert.Equal(t, tc.expected, list)
+		})
+	}
+
+	t.Run("OutOfBounds", func(t *testing.T) {
+		list := List[int]{0, 1, 2}
+		for _, p := range [][2]int{{-1, 0}, {0, 3}, {3, 0}, {0, -1}} {
+			assertError(t, list.Move(p[0], p[1]), hold.ErrBoundsOutOfRange)
+		}
+		assert.Equal(t, List[int]{0, 1, 2}, list)
+
+		empty := List[int]{}
+		assertError(t, empty.Move(0, 0), hold.ErrBoundsOutOfRange)
+	})
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected List[int]
+	}{
+		{name: "Left", n: 2, expected: List[int]{2, 3, 4, 0, 1}},
+		{name: "Right", n: -2, expected: List[int]{3, 4, 0, 1, 2}},
+		{name: "OverLengthLeft", n: 7, expected: List[int]{2, 3, 4, 0, 1}},
+		{name: "OverLengthRight", n: -11, expected: List[int]{4, 0, 1, 2, 3}},
+		{name: "MultipleOfLength", n: 10, expected: List[int]{0, 1, 2, 3, 4}},
+		{name: "NoOp", n: 0, expected: List[int]{0, 1, 2, 3, 4}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			list := List[int]{0, 1, 2, 3, 4}
+			list.Rotate(tc.n)
+			assert.Equal(t, tc.expected, list)
+		})
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		list := List[int]{}
+		list.Rotate(3)
+		assert.True(t, list.IsEmpty())
+	})
+}
+
+func TestBinarySearch(t *testing.T) {
+	list := List[int]{1, 3, 3, 3, 7, 9}
+
+	tests := []struct {
+		value int
+		index int
+		found bool
+	}{
+		{value: 0, index: 0, found: false},
+		{value: 1, index: 0, found: true},
+		{value: 3, index: 1, found: true},
+		{value: 4, index: 4, found: false},
+		{value: 9, index: 5, found: true},
+		{value: 10, index: 6, found: false},
+	}
+
+	for _, tc := range tests {
+		i, found := list.BinarySearch(tc.value, cmp.Compare[int])
+		assert.Equal(t, tc.index, i, "value: %d", tc.value)
+		assert.Equal(t, tc.found, found, "value: %d", tc.value)
+	}
+
+	empty := List[int]{}
+	i, found := empty.BinarySearch(1, cmp.Compare[int])
+	assert.Equal(t, 0, i)
+	assert.False(t, found)
+}
+
+func TestInsertSorted(t *testing.T) {
+	list := List[int]{}
+	for _, v := range []int{5, 1, 9, 3, 3, 0, 10} {
+		list.InsertSorted(v, cmp.Compare[int])
+	}
+	assert.Equal(t, List[int]{0, 1, 3, 3, 5, 9, 10}, list)
+
+	type player struct {
+		name  string
+		score int
+	}
+
+	byScore := func(a, b player) int { return cmp.Compare(a.score, b.score) }
+	players := List[player]{}
+	players.InsertSorted(player{name: "luffy", score: 2}, byScore)
+	players.InsertSorted(player{name: "zoro", score: 1}, byScore)
+	players.InsertSorted(player{name: "sanji", score: 2}, byScore)
+	assert.Equal(t, List[player]{{"zoro", 1}, {"luffy", 2}, {"sanji", 2}}, players)
+}
+
+func TestDistinct(t *testing.T) {
+	t.Run("Duplicates", func(t *testing.T) {
+		list := List[string]{"zoro", "luffy", "zoro", "nami", "luffy", "sanji", "nami"}
+		list.Distinct()
+		assert.Equal(t, List[string]{"zoro", "luffy", "nami", "sanji"}, list)
+	})
+
+	t.Run("Identical", func(t *testing.T) {
+		list := List[int]{7, 7, 7, 7, 7}
+		list.Distinct()
+		assert.Equal(t, List[int]{7}, list)
+	})
+
+	t.Run("AlreadyDistinct", func(t *testing.T) {
+		list := List[int]{3, 1, 2}
+		list.Distinct()
+		assert.Equal(t, List[int]{3, 1, 2}, list)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		list := List[int]{}
+		list.Distinct()
+		assert.True(t, list.IsEmpty())
+	})
+
+	t.Run("Unhashable", func(t *testing.T) {
+		list := List[any]{[]int{1}, "luffy", []int{1}, []int{2}, "luffy"}
+		list.Distinct()
+		assert.Equal(t, List[any]{[]int{1}, "luffy", []int{2}}, list)
+		assert.Equal(t, 1, list.Count([]int{1}))
+	})
+
+	t.Run("Pointers", func(t *testing.T) {
+		type crew struct{ name string }
+
+		a, b := &crew{name: "zoro"}, &crew{name: "zoro"}
+		list := List[*crew]{a, b, &crew{name: "nami"}}
+		assert.Equal(t, 2, list.Count(a))
+
+		// Pointers to equal values are duplicates, as they are for Count.
+		list.Distinct()
+		assert.Len(t, list, 2)
+		assert.Same(t, a, list[0])
+	})
+
+	t.Run("DistinctCopy", func(t *testing.T) {
+		list := List[int]{1, 2, 1, 3, 2}
+		distinct := list.DistinctCopy()
+		assert.Equal(t, List[int]{1, 2, 3}, *distinct)
+		assert.Equal(t, List[int]{1, 2, 1, 3, 2}, list)
+	})
+}
+
+func TestSort(t *testing.T) {
+	t.Run("Ascending", func(t *testing.T) {
+		list := List[int]{5, 2, 9, 1, 5, 6}
+		list.Sort(cmp.Compare[int])
+		assert.Equal(t, List[int]{1, 2, 5, 5, 6, 9}, list)
+	})
+
+	t.Run("Descending", func(t *testing.T) {
+		list := List[string]{"zoro", "luffy", "sanji", "nami"}
+		list.Sort(func(a, b string) int { return cmp.Compare(b, a) })
+		assert.Equal(t, List[string]{"zoro", "sanji", "nami", "luffy"}, list)
+	})
+
+	t.Run("Field", func(t *testing.T) {
+		type player struct {
+			name  string
+			score int
+		}
+
+		list := List[player]{{"luffy", 3}, {"zoro", 1}, {"sanji", 3}, {"nami", 2}, {"usopp", 1}}
+		list.Sort(func(a, b player) int { return cmp.Compare(a.score, b.score) })
+		assert.Equal(t, List[player]{{"zoro", 1}, {"usopp", 1}, {"nami", 2}, {"luffy", 3}, {"sanji", 3}}, list)
+	})
+
+	t.Run("SortedCopy", func(t *testing.T) {
+		list := List[int]{3, 1, 2}
+		sorted := list.SortedCopy(cmp.Compare[int])
+		assert.Equal(t, List[int]{1, 2, 3}, *sorted)
+		assert.Equal(t, List[int]{3, 1, 2}, list)
+
+		empty := List[int]{}
+		assert.True(t, empty.SortedCopy(cmp.Compare[int]).IsEmpty())
+	})
+}
+
+func TestShuffle(t *testing.T) {
+	values := List[int]{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	list := values.Clone()
+	list.Shuffle(rand.New(rand.NewPCG(1, 2)))
+	assert.NotEqual(t, values, *list)
+	assert.ElementsMatch(t, values, *list)
+
+	seeded := values.Clone()
+	seeded.Shuffle(rand.New(rand.NewPCG(1, 2)))
+	assert.Equal(t, *list, *seeded)
+
+	shuffled := values.Shuffled(rand.New(rand.NewPCG(1, 2)))
+	assert.Equal(t, *list, *shuffled)
+	assert.Equal(t, List[int]{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, values)
+
+	list = values.Shuffled(nil)
+	assert.ElementsMatch(t, values, *list)
+	assert.True(t, slices.IsSorted(values))
+
+	empty := List[int]{}
+	empty.Shuffle(nil)
+	assert.True(t, empty.IsEmpty())
+}
+
+func TestSubList(t *testing.T) {
+	list := List[string]{"luffy", "zoro", "nami", "usopp", "sanji"}
+
+	t.Run("Range", func(t *testing.T) {
+		sub, err := list.SubList(1, 4)
+		assertError(t, err, nil)
+		assert.Equal(t, List[string]{"zoro", "nami", "usopp"}, *sub)
+
+		sub.Clear()
+		assert.Equal(t, 5, list.Len())
+	})
+
+	t.Run("Full", func(t *testing.T) {
+		sub, err := list.SubList(0, list.Len())
+		assertError(t, err, nil)
+		assert.Equal(t, list, *sub)
+
+		(*sub)[0] = "chopper"
+		assert.Equal(t, "luffy", list[0])
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		sub, err := list.SubList(2, 2)
+		assertError(t, err, nil)
+		assert.True(t, sub.IsEmpty())
+
+		sub, err = list.SubList(list.Len(), list.Len())
+		assertError(t, err, nil)
+		assert.True(t, sub.IsEmpty())
+	})
+
+	t.Run("OutOfBounds", func(t *testing.T) {
+		for _, r := range [][2]int{{3, 1}, {-1, 2}, {0, 6}, {6, 6}} {
+			sub, err := list.SubList(r[0], r[1])
+			assertError(t, err, hold.ErrBoundsOutOfRange)
+			assert.Nil(t, sub)
+		}
+	})
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		list := List[int]{3, 1, 2}
+		b, err := json.Marshal(&list)
+		assertError(t, err, nil)
+		assert.Equal(t, "[3,1,2]", string(b))
+
+		decoded := List[int]{9, 9, 9, 9}
+		err = json.Unmarshal(b, &decoded)
+		assertError(t, err, nil)
+		assert.Equal(t, list, decoded)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		list := List[string]{"luffy", "zoro", "sanji"}
+		b, err := json.Marshal(list)
+		assertError(t, err, nil)
+		assert.Equal(t, `["luffy","zoro","sanji"]`, string(b))
+
+		var decoded List[string]
+		err = json.Unmarshal(b, &decoded)
+		assertError(t, err, nil)
+		assert.Equal(t, list, decoded)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		var list List[string]
+		b, err := json.Marshal(list)
+		assertError(t, err, nil)
+		assert.Equal(t, "[]", string(b))
+
+		b, err = json.Marshal(&List[string]{})
+		assertError(t, err, nil)
+		assert.Equal(t, "[]", string(b))
+
+		b, err = json.Marshal(struct {
+			Values List[int] `json:"values"`
+		}{})
+		assertError(t, err, nil)
+		assert.Equal(t, `{"values":[]}`, string(b))
+
+		decoded := List[string]{"nami"}
+		err = json.Unmarshal([]byte("[]"), &decoded)
+		assertError(t, err, nil)
+		assert.True(t, decoded.IsEmpty())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		decoded := List[int]{1}
+		err := json.Unmarshal([]byte(`["a"]`), &decoded)
+		assert.Error(t, err)
+		assert.Equal(t, List[int]{1}, decoded)
+	})
+}
+
+func TestGob(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		list := List[int]{3, 1, 2}
+
+		var buf bytes.Buffer
+		assertError(t, gob.NewEncoder(&buf).Encode(list), nil)
+
+		decoded := List[int]{9, 9, 9, 9}
+		assertError(t, gob.NewDecoder(&buf).Decode(&decoded), nil)
+		assert.Equal(t, list, decoded)
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		type crew struct {
+			Name    string
+			Members List[string]
+			Bounty  *List[int]
+		}
+
+		expected := crew{Name: "straw hat", Members: List[string]{"luffy", "zoro"}, Bounty: &List[int]{3000, 1111}}
+
+		var buf bytes.Buffer
+		assertError(t, gob.NewEncoder(&buf).Encode(expected), nil)
+
+		var decoded crew
+		assertError(t, gob.NewDecoder(&buf).Decode(&decoded), nil)
+		assert.Equal(t, expected, decoded)
+	})
+
+	t.Run("Any", func(t *testing.T) {
+		gob.Register(map[string]int{})
+
+		list := List[any]{"luffy", 19, map[string]int{"bounty": 3000}}
+
+		var buf bytes.Buffer
+		assertError(t, gob.NewEncoder(&buf).Encode(list), nil)
+
+		var decoded List[any]
+		assertError(t, gob.NewDecoder(&buf).Decode(&decoded), nil)
+		assert.Equal(t, list, decoded)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		var buf bytes.Buffer
+		assertError(t, gob.NewEncoder(&buf).Encode(List[string]{}), nil)
+
+		decoded := List[string]{"nami"}
+		assertError(t, gob.NewDecoder(&buf).Decode(&decoded), nil)
+		assert.True(t, decoded.IsEmpty())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		decoded := List[int]{1}
+		assert.Error(t, decoded.GobDecode([]byte("invalid")))
+		assert.Equal(t, List[int]{1}, decoded)
+	})
+}
+
 func assertContains(t *testing.T, collection hold.Collection[entry], value entry, expected bool) {
 	t.Helper()
 	if collection.Contains(value) != expected {
@@ -218,10 +1072,11 @@ func assertError(t *testing.T, actual error, expected error) {
 func assertIndex(t *testing.T, list List[entry], value entry, expected int) {
 	t.Helper()
 	actual, err := list.Index(value)
-	if err != nil {
+	if err != nil || actual != expected {
 		t.Errorf("expected index of '%d', but found '%d'", expected, actual)
 	}
 }
+
 func assertSize(t *testing.T, list List[entry], expected int) {
 	t.Helper()
 	actual := list.Len()
@@ -229,3 +1084,38 @@ func assertSize(t *testing.T, list List[entry], expected int) {
 		t.Errorf("expected size of '%d', but found '%d'", expected, actual)
 	}
 }
+
+func BenchmarkList_Add(b *testing.B) {
+	const n = 100_000
+
+	b.Run("Default", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			list := List[int]{}
+			for i := range n {
+				_ = list.Add(i)
+			}
+		}
+	})
+
+	b.Run("WithCapacity", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			list := WithCapacity[int](n)
+			for i := range n {
+				_ = list.Add(i)
+			}
+		}
+	})
+
+	b.Run("Grow", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			list := List[int]{}
+			list.Grow(n)
+			for i := range n {
+				_ = list.Add(i)
+			}
+		}
+	})
+}
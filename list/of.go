@@ -0,0 +1,24 @@
+package list
+
+import "github.com/transientvariable/hold"
+
+// Of creates a new List containing the entries of each of the provided collections, in the order the collections are
+// provided and in the iteration order of each collection.
+//
+// Nil collections are skipped.
+func Of[E comparable](collections ...hold.Collection[E]) *List[E] {
+	var n int
+	for _, c := range collections {
+		if c != nil {
+			n += c.Len()
+		}
+	}
+
+	l := make(List[E], 0, n)
+	for _, c := range collections {
+		if c != nil {
+			l = append(l, c.Values()...)
+		}
+	}
+	return &l
+}
@@ -0,0 +1,33 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+	"github.com/transientvariable/hold/trie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOf(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("zoro", "nami"))
+
+	l := list.Of[string](
+		&list.List[string]{"luffy", "usopp"},
+		tr,
+		nil,
+		&list.List[string]{},
+		&list.List[string]{"luffy"},
+	)
+	assert.Equal(t, list.List[string]{"luffy", "usopp", "nami", "zoro", "luffy"}, *l)
+
+	l = list.Of[string]()
+	assert.True(t, l.IsEmpty())
+
+	var c hold.Collection[string]
+	l = list.Of(c)
+	assert.True(t, l.IsEmpty())
+}
@@ -0,0 +1,63 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/transientvariable/hold"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Average returns the arithmetic mean of the values in the provided List.
+//
+// The returned error will be non-nil if the List is nil or empty (has no elements).
+func Average[E Number](l *List[E]) (float64, error) {
+	if l == nil || len(*l) == 0 {
+		return 0, fmt.Errorf("list: %w", hold.ErrCollectionEmpty)
+	}
+
+	var sum float64
+	for _, v := range *l {
+		sum += float64(v)
+	}
+	return sum / float64(len(*l)), nil
+}
+
+// Max returns the greatest value in the provided List.
+//
+// The returned error will be non-nil if the List is nil or empty (has no elements).
+func Max[E Number](l *List[E]) (E, error) {
+	if l == nil || len(*l) == 0 {
+		var n E
+		return n, fmt.Errorf("list: %w", hold.ErrCollectionEmpty)
+	}
+	return slices.Max(*l), nil
+}
+
+// Min returns the least value in the provided List.
+//
+// The returned error will be non-nil if the List is nil or empty (has no elements).
+func Min[E Number](l *List[E]) (E, error) {
+	if l == nil || len(*l) == 0 {
+		var n E
+		return n, fmt.Errorf("list: %w", hold.ErrCollectionEmpty)
+	}
+	return slices.Min(*l), nil
+}
+
+// Sum returns the sum of the values in the provided List, which will be the zero value if the List is nil or empty.
+func Sum[E Number](l *List[E]) E {
+	var sum E
+	if l != nil {
+		for _, v := range *l {
+			sum += v
+		}
+	}
+	return sum
+}
@@ -0,0 +1,59 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats_Int(t *testing.T) {
+	l := &list.List[int]{4, -2, 9, 1, 3}
+	assert.Equal(t, 15, list.Sum(l))
+
+	v, err := list.Min(l)
+	assert.NoError(t, err)
+	assert.Equal(t, -2, v)
+
+	v, err = list.Max(l)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, v)
+
+	avg, err := list.Average(l)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, avg)
+}
+
+func TestStats_Float(t *testing.T) {
+	l := &list.List[float64]{1.5, 2.25, -0.75}
+	assert.InDelta(t, 3.0, list.Sum(l), 1e-9)
+
+	v, err := list.Min(l)
+	assert.NoError(t, err)
+	assert.Equal(t, -0.75, v)
+
+	v, err = list.Max(l)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.25, v)
+
+	avg, err := list.Average(l)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0, avg, 1e-9)
+}
+
+func TestStats_Empty(t *testing.T) {
+	for _, l := range []*list.List[int]{{}, nil} {
+		assert.Equal(t, 0, list.Sum(l))
+
+		_, err := list.Min(l)
+		assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+		_, err = list.Max(l)
+		assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+		_, err = list.Average(l)
+		assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+	}
+}
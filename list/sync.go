@@ -0,0 +1,188 @@
+package list
+
+import (
+	"sync"
+
+	"github.com/transientvariable/hold"
+)
+
+var _ hold.Sequence[any] = (*SyncList[any])(nil)
+
+// SyncList is a Sequence that guards access to an underlying List with a sync.RWMutex, making it safe for concurrent
+// use by multiple goroutines.
+//
+// Methods that only read the List acquire the read lock, while methods that modify it acquire the write lock.
+type SyncList[E comparable] struct {
+	list  *List[E]
+	mutex sync.RWMutex
+}
+
+// Synchronized creates a new SyncList that wraps the provided List. If the provided List is nil, an empty List is
+// used.
+//
+// The provided List should not be accessed directly once it has been wrapped.
+func Synchronized[E comparable](l *List[E]) *SyncList[E] {
+	if l == nil {
+		l = &List[E]{}
+	}
+	return &SyncList[E]{list: l}
+}
+
+// Add inserts the provided entry into the SyncList.
+func (s *SyncList[E]) Add(entry ...E) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.Add(entry...)
+}
+
+// AddAll inserts all entries from the provided collection into the SyncList.
+func (s *SyncList[E]) AddAll(collection hold.Collection[E]) error {
+	if collection == nil {
+		return nil
+	}
+
+	entries := collection.Values()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.Add(entries...)
+}
+
+// AddAt inserts the provided entry into the SyncList specified by index.
+//
+// The position of the entries that were at positions index to SyncList.Size() - 1 increase by one. The returned error
+// will be non-nil if the provided index is outside the current bounds of the SyncList
+// (index < 0 || index > SyncList.Size()).
+func (s *SyncList[E]) AddAt(index int, entry E) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.AddAt(index, entry)
+}
+
+// AddFirst inserts the provided value at the front (index == 0) of the SyncList.
+func (s *SyncList[E]) AddFirst(value E) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.AddFirst(value)
+}
+
+// AddLast inserts the provided value at the end of the SyncList (index == SyncList.Size()).
+func (s *SyncList[E]) AddLast(value E) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.AddLast(value)
+}
+
+// Clear removes all entries from the SyncList.
+func (s *SyncList[E]) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.list.Clear()
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the SyncList, otherwise false is
+// returned.
+func (s *SyncList[E]) Contains(value E) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list.Contains(value)
+}
+
+// Index returns the position of the first occurrence (if any) of an entry equivalent to the provided entry.
+//
+// The returned error will be non-nil if provided entry is not found in the SyncList.
+func (s *SyncList[E]) Index(value E) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list.Index(value)
+}
+
+// IndexFrom returns the position of the first occurrence (if any) of an entry equivalent to the provided entry at or
+// after the provided start position.
+//
+// The returned error will be non-nil if the provided start position is outside the current bounds of the SyncList, or
+// if no such entry is found.
+func (s *SyncList[E]) IndexFrom(value E, start int) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list.IndexFrom(value, start)
+}
+
+// IsEmpty returns true if the SyncList contains no entries, otherwise false is returned.
+func (s *SyncList[E]) IsEmpty() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list.IsEmpty()
+}
+
+// Iterate returns the collection.Iterator for the SyncList.
+//
+// The returned iterator operates on a snapshot of the entries taken at the time of the call, so it is unaffected by
+// concurrent modifications to the SyncList. For the same reason, entries removed through the iterator are only removed
+// from the snapshot and not from the SyncList.
+func (s *SyncList[E]) Iterate() hold.Iterator[E] {
+	values := List[E](s.Values())
+	return newIterator(&values)
+}
+
+// Len returns the number of entries in the SyncList.
+func (s *SyncList[E]) Len() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list.Len()
+}
+
+// Remove removes the first occurrence (if any) of an entry equivalent to the provided value.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (s *SyncList[E]) Remove(value E) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.Remove(value)
+}
+
+// RemoveAt removes the entry at the provided index from the SyncList and returns it.
+//
+// The returned error will be non-nil if the provided index is outside the bounds of the SyncList.
+func (s *SyncList[E]) RemoveAt(index int) (E, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.RemoveAt(index)
+}
+
+// RemoveFirst removes the entry at the front (index == 0) of the SyncList and returns it.
+func (s *SyncList[E]) RemoveFirst() (E, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.RemoveFirst()
+}
+
+// RemoveLast removes the entry at the end (index == SyncList.Size() - 1) of the SyncList and returns it.
+func (s *SyncList[E]) RemoveLast() (E, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.list.RemoveLast()
+}
+
+// ValueAt returns the entry at the position specified by the provided index.
+//
+// The returned error will be non-nil if the provided index is outside the current bounds of the SyncList.
+func (s *SyncList[E]) ValueAt(index int) (E, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list.ValueAt(index)
+}
+
+// Values returns a slice containing the entries in the SyncList in the iteration order.
+func (s *SyncList[E]) Values() []E {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list.Values()
+}
+
+// String returns a string representation of the SyncList in it's current state.
+func (s *SyncList[E]) String() string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.list.String()
+}
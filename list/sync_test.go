@@ -0,0 +1,75 @@
+package list
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncList(t *testing.T) {
+	const (
+		numWriters = 8
+		numEntries = 200
+	)
+
+	list := Synchronized[int](nil)
+
+	var wg sync.WaitGroup
+	for w := 0; w < numWriters; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < numEntries; i++ {
+				assert.NoError(t, list.Add(w*numEntries+i))
+			}
+		}(w)
+	}
+
+	for r := 0; r < numWriters; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < numEntries; i++ {
+				_ = list.Contains(i)
+				_, _ = list.Index(i)
+				_ = list.Len()
+
+				iter := list.Iterate()
+				for iter.HasNext() {
+					_, err := iter.Next()
+					assert.NoError(t, err)
+				}
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < numEntries; i++ {
+			assert.NoError(t, list.AddFirst(-1))
+			r, err := list.Remove(-1)
+			assert.NoError(t, err)
+			assert.True(t, r)
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, numWriters*numEntries, list.Len())
+
+	t.Run("Iterate", func(t *testing.T) {
+		list := Synchronized(&List[string]{"a", "b", "c"})
+		iter := list.Iterate()
+		list.Clear()
+
+		var values []string
+		for iter.HasNext() {
+			v, err := iter.Next()
+			assert.NoError(t, err)
+			values = append(values, v)
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, values)
+		assert.True(t, list.IsEmpty())
+	})
+}
@@ -0,0 +1,158 @@
+package multiset
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/transientvariable/hold"
+)
+
+var _ hold.Collection[any] = (*MultiSet[any])(nil)
+
+type iterator[E comparable] struct {
+	entries []E
+	index   int
+}
+
+func (i *iterator[E]) HasNext() bool {
+	return i.index < len(i.entries)
+}
+
+func (i *iterator[E]) Next() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("multiset_iter: %w", hold.ErrNoMoreElements)
+	}
+	n = i.entries[i.index]
+	i.index++
+	return n, nil
+}
+
+// MultiSet is an implementation of a Collection that holds duplicate entries by counting the number of occurrences
+// (the multiplicity) of each distinct entry.
+//
+// The iteration order of a MultiSet is unspecified, except that the occurrences of an entry are visited consecutively.
+// The zero value is an empty MultiSet ready to use. This implementation does not make any guarantees for concurrent
+// access.
+type MultiSet[E comparable] struct {
+	counts map[E]int
+	size   int
+}
+
+// New creates a new MultiSet containing the provided entries.
+func New[E comparable](entries ...E) *MultiSet[E] {
+	m := &MultiSet[E]{counts: make(map[E]int, len(entries))}
+	_ = m.Add(entries...)
+	return m
+}
+
+// Add inserts the provided entries into the MultiSet, incrementing the multiplicity of each entry by one for every
+// occurrence.
+func (m *MultiSet[E]) Add(entry ...E) error {
+	if m.counts == nil {
+		m.counts = make(map[E]int, len(entry))
+	}
+
+	for _, e := range entry {
+		m.counts[e]++
+	}
+	m.size += len(entry)
+	return nil
+}
+
+// AddAll inserts all entries from the provided collection into the MultiSet.
+func (m *MultiSet[E]) AddAll(collection hold.Collection[E]) error {
+	if collection != nil {
+		return m.Add(collection.Values()...)
+	}
+	return nil
+}
+
+// Clear removes all entries from the MultiSet.
+func (m *MultiSet[E]) Clear() {
+	clear(m.counts)
+	m.size = 0
+}
+
+// Contains returns true if at least one entry equivalent to the provided value exists in the MultiSet, otherwise false
+// is returned.
+func (m *MultiSet[E]) Contains(value E) bool {
+	return m.CountOf(value) > 0
+}
+
+// CountOf returns the multiplicity of the provided value, which is the number of occurrences of entries equivalent to
+// the value in the MultiSet.
+func (m *MultiSet[E]) CountOf(value E) int {
+	return m.counts[value]
+}
+
+// Distinct returns the number of distinct entries in the MultiSet.
+func (m *MultiSet[E]) Distinct() int {
+	return len(m.counts)
+}
+
+// IsEmpty returns true if the MultiSet contains no entries, otherwise false is returned.
+func (m *MultiSet[E]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// Iterate returns the collection.Iterator for the MultiSet.
+//
+// The returned iterator visits the entries that were in the MultiSet at the time of the call, once for each
+// occurrence.
+func (m *MultiSet[E]) Iterate() hold.Iterator[E] {
+	return &iterator[E]{entries: m.Values()}
+}
+
+// Len returns the number of entries in the MultiSet, which is the sum of the multiplicities of its distinct entries.
+func (m *MultiSet[E]) Len() int {
+	return m.size
+}
+
+// Remove removes a single occurrence of the entry equivalent to the provided value (if any), decrementing its
+// multiplicity by one.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (m *MultiSet[E]) Remove(value E) (bool, error) {
+	n := m.counts[value]
+	if n == 0 {
+		return false, nil
+	}
+
+	if n == 1 {
+		delete(m.counts, value)
+	} else {
+		m.counts[value] = n - 1
+	}
+	m.size--
+	return true, nil
+}
+
+// Values returns a slice containing the entries in the MultiSet, where each entry is repeated according to its
+// multiplicity. The order of the distinct entries is unspecified, but the occurrences of each entry are adjacent.
+func (m *MultiSet[E]) Values() []E {
+	entries := make([]E, 0, m.Len())
+	for e, n := range m.counts {
+		for range n {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// String returns a string representation of the MultiSet in it's current state.
+//
+// The entries are sorted by their string representation so that the output is deterministic.
+func (m *MultiSet[E]) String() string {
+	if m.Len() == 0 {
+		return "[]"
+	}
+
+	entries := make([]string, 0, m.Len())
+	for _, e := range m.Values() {
+		entries = append(entries, fmt.Sprintf("%v", e))
+	}
+	slices.Sort(entries)
+	return "[" + strings.Join(entries, ", ") + "]"
+}
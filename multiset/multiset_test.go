@@ -0,0 +1,112 @@
+package multiset
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiSet_Add(t *testing.T) {
+	m := New("luffy", "zoro", "luffy")
+	assertSize(t, m, 3)
+	assert.Equal(t, 2, m.Distinct())
+	assert.Equal(t, 2, m.CountOf("luffy"))
+	assert.Equal(t, 1, m.CountOf("zoro"))
+	assert.Equal(t, 0, m.CountOf("sanji"))
+
+	err := m.AddAll(&list.List[string]{"sanji", "luffy", "sanji"})
+	assert.NoError(t, err)
+	assertSize(t, m, 6)
+	assert.Equal(t, 3, m.CountOf("luffy"))
+	assert.Equal(t, 2, m.CountOf("sanji"))
+	assertContentEquals(t, m, "[luffy, luffy, luffy, sanji, sanji, zoro]")
+
+	var zero MultiSet[int]
+	err = zero.Add(1, 1)
+	assert.NoError(t, err)
+	assert.True(t, zero.Contains(1))
+	assert.Equal(t, 2, zero.CountOf(1))
+}
+
+func TestMultiSet_Remove(t *testing.T) {
+	m := New("luffy", "zoro", "luffy", "luffy")
+
+	r, err := m.Remove("luffy")
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assert.Equal(t, 2, m.CountOf("luffy"))
+	assertSize(t, m, 3)
+
+	r, err = m.Remove("zoro")
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assert.False(t, m.Contains("zoro"))
+	assert.Equal(t, 1, m.Distinct())
+
+	r, err = m.Remove("zoro")
+	assert.NoError(t, err)
+	assert.False(t, r)
+	assertSize(t, m, 2)
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, 0, m.CountOf("luffy"))
+	assertContentEquals(t, m, "[]")
+
+	var zero MultiSet[int]
+	r, err = zero.Remove(1)
+	assert.NoError(t, err)
+	assert.False(t, r)
+}
+
+func TestMultiSet_Values(t *testing.T) {
+	m := New(3, 1, 3, 2, 3, 1)
+
+	values := m.Values()
+	assert.Len(t, values, 6)
+	assert.ElementsMatch(t, []int{1, 1, 2, 3, 3, 3}, values)
+
+	for i := 1; i < len(values); i++ {
+		if values[i] != values[i-1] {
+			assert.NotContains(t, values[i:], values[i-1], "occurrences of %d are not adjacent", values[i-1])
+		}
+	}
+
+	var visited []int
+	iter := m.Iterate()
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		visited = append(visited, v)
+	}
+	assert.ElementsMatch(t, values, visited)
+
+	_, err := iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	empty := New[int]()
+	assert.Empty(t, empty.Values())
+	assert.False(t, empty.Iterate().HasNext())
+}
+
+func assertContentEquals[E comparable](t *testing.T, collection hold.Collection[E], expected string) {
+	t.Helper()
+
+	actual := fmt.Sprintf("%s", collection)
+	if actual != expected {
+		t.Errorf("expected content of '%s', but found '%s'", expected, actual)
+	}
+}
+
+func assertSize[E comparable](t *testing.T, collection hold.Collection[E], expected int) {
+	t.Helper()
+
+	actual := collection.Len()
+	if actual != expected {
+		t.Errorf("expected size of '%d', but found '%d'", expected, actual)
+	}
+}
@@ -0,0 +1,229 @@
+package queue
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/transientvariable/hold"
+)
+
+var _ hold.Collection[any] = (*Deque[any])(nil)
+
+// minCapacity is the capacity of the ring buffer allocated when the first entry is inserted into a Deque.
+const minCapacity = 8
+
+type iterator[E comparable] struct {
+	entries []E
+	index   int
+}
+
+func (i *iterator[E]) HasNext() bool {
+	return i.index < len(i.entries)
+}
+
+func (i *iterator[E]) Next() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("queue_iter: %w", hold.ErrNoMoreElements)
+	}
+	n = i.entries[i.index]
+	i.index++
+	return n, nil
+}
+
+// Deque is a double-ended queue backed by a ring buffer, which supports amortized O(1) insertion and removal at both
+// the front and back.
+//
+// The iteration order of a Deque is from the front to the back. This implementation does not make any guarantees for
+// concurrent access.
+type Deque[E comparable] struct {
+	buffer []E
+	head   int
+	size   int
+}
+
+// NewDeque creates a new Deque and inserts the provided entries at the back in order.
+func NewDeque[E comparable](entries ...E) *Deque[E] {
+	d := &Deque[E]{}
+	_ = d.Add(entries...)
+	return d
+}
+
+// Add inserts the provided entries at the back of the Deque in order.
+func (d *Deque[E]) Add(entry ...E) error {
+	for _, e := range entry {
+		d.PushBack(e)
+	}
+	return nil
+}
+
+// AddAll inserts all entries from the provided collection at the back of the Deque in the iteration order of the
+// collection.
+func (d *Deque[E]) AddAll(collection hold.Collection[E]) error {
+	if collection != nil {
+		return d.Add(collection.Values()...)
+	}
+	return nil
+}
+
+// Clear removes all entries from the Deque.
+func (d *Deque[E]) Clear() {
+	clear(d.buffer)
+	d.head = 0
+	d.size = 0
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the Deque, otherwise false is returned.
+func (d *Deque[E]) Contains(value E) bool {
+	return d.index(value) >= 0
+}
+
+// IsEmpty returns true if the Deque contains no entries, otherwise false is returned.
+func (d *Deque[E]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Iterate returns the collection.Iterator for the Deque, which visits entries from the front to the back.
+func (d *Deque[E]) Iterate() hold.Iterator[E] {
+	return &iterator[E]{entries: d.Values()}
+}
+
+// Len returns the number of entries in the Deque.
+func (d *Deque[E]) Len() int {
+	return d.size
+}
+
+// PeekBack returns the entry at the back of the Deque without removing it.
+//
+// The returned error will be non-nil if the Deque is empty.
+func (d *Deque[E]) PeekBack() (E, error) {
+	if d.IsEmpty() {
+		var e E
+		return e, fmt.Errorf("deque: %w", hold.ErrCollectionEmpty)
+	}
+	return d.buffer[d.position(d.size-1)], nil
+}
+
+// PeekFront returns the entry at the front of the Deque without removing it.
+//
+// The returned error will be non-nil if the Deque is empty.
+func (d *Deque[E]) PeekFront() (E, error) {
+	if d.IsEmpty() {
+		var e E
+		return e, fmt.Errorf("deque: %w", hold.ErrCollectionEmpty)
+	}
+	return d.buffer[d.head], nil
+}
+
+// PopBack removes the entry at the back of the Deque and returns it.
+//
+// The returned error will be non-nil if the Deque is empty.
+func (d *Deque[E]) PopBack() (E, error) {
+	entry, err := d.PeekBack()
+	if err != nil {
+		return entry, err
+	}
+
+	var zero E
+	d.buffer[d.position(d.size-1)] = zero
+	d.size--
+	return entry, nil
+}
+
+// PopFront removes the entry at the front of the Deque and returns it.
+//
+// The returned error will be non-nil if the Deque is empty.
+func (d *Deque[E]) PopFront() (E, error) {
+	entry, err := d.PeekFront()
+	if err != nil {
+		return entry, err
+	}
+
+	var zero E
+	d.buffer[d.head] = zero
+	d.head = d.position(1)
+	d.size--
+	return entry, nil
+}
+
+// PushBack inserts the provided entry at the back of the Deque.
+func (d *Deque[E]) PushBack(entry E) {
+	d.grow()
+	d.buffer[d.position(d.size)] = entry
+	d.size++
+}
+
+// PushFront inserts the provided entry at the front of the Deque.
+func (d *Deque[E]) PushFront(entry E) {
+	d.grow()
+	d.head = d.position(len(d.buffer) - 1)
+	d.buffer[d.head] = entry
+	d.size++
+}
+
+// Remove removes the first occurrence (if any), from the front, of an entry equivalent to the provided value.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (d *Deque[E]) Remove(value E) (bool, error) {
+	i := d.index(value)
+	if i < 0 {
+		return false, nil
+	}
+
+	for ; i < d.size-1; i++ {
+		d.buffer[d.position(i)] = d.buffer[d.position(i+1)]
+	}
+
+	var zero E
+	d.buffer[d.position(d.size-1)] = zero
+	d.size--
+	return true, nil
+}
+
+// Values returns a slice containing the entries in the Deque in the iteration order, from the front to the back.
+func (d *Deque[E]) Values() []E {
+	entries := make([]E, d.size)
+	n := copy(entries, d.buffer[d.head:min(d.head+d.size, len(d.buffer))])
+	copy(entries[n:], d.buffer[:d.size-n])
+	return entries
+}
+
+// String returns a string representation of the Deque in it's current state, from the front to the back.
+func (d *Deque[E]) String() string {
+	if d.size == 0 {
+		return "[]"
+	}
+
+	entries := make([]string, 0, d.size)
+	for _, e := range d.Values() {
+		entries = append(entries, fmt.Sprintf("%v", e))
+	}
+	return "[" + strings.Join(entries, ", ") + "]"
+}
+
+// grow doubles the capacity of the ring buffer if it is full, moving the entries so the front is at position 0.
+func (d *Deque[E]) grow() {
+	if d.size < len(d.buffer) {
+		return
+	}
+
+	buffer := make([]E, max(minCapacity, len(d.buffer)*2))
+	copy(buffer, d.Values())
+	d.buffer = buffer
+	d.head = 0
+}
+
+func (d *Deque[E]) index(value E) int {
+	for i := 0; i < d.size; i++ {
+		if reflect.DeepEqual(d.buffer[d.position(i)], value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// position returns the position in the ring buffer of the entry at the provided offset from the front.
+func (d *Deque[E]) position(offset int) int {
+	return (d.head + offset) % len(d.buffer)
+}
@@ -0,0 +1,104 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/transientvariable/hold"
+)
+
+var _ hold.Collection[any] = (*Queue[any])(nil)
+
+// Queue is a first-in-first-out (FIFO) Collection backed by a ring buffer, which supports amortized O(1) insertion and
+// removal.
+//
+// The iteration order of a Queue is from the front (the next entry to be dequeued) to the back. This implementation
+// does not make any guarantees for concurrent access.
+type Queue[E comparable] struct {
+	deque Deque[E]
+}
+
+// New creates a new Queue and enqueues the provided entries in order.
+func New[E comparable](entries ...E) *Queue[E] {
+	q := &Queue[E]{}
+	_ = q.Add(entries...)
+	return q
+}
+
+// Add enqueues the provided entries in order.
+func (q *Queue[E]) Add(entry ...E) error {
+	return q.deque.Add(entry...)
+}
+
+// AddAll enqueues all entries from the provided collection in the iteration order of the collection.
+func (q *Queue[E]) AddAll(collection hold.Collection[E]) error {
+	return q.deque.AddAll(collection)
+}
+
+// Clear removes all entries from the Queue.
+func (q *Queue[E]) Clear() {
+	q.deque.Clear()
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the Queue, otherwise false is returned.
+func (q *Queue[E]) Contains(value E) bool {
+	return q.deque.Contains(value)
+}
+
+// Dequeue removes the entry at the front of the Queue and returns it.
+//
+// The returned error will be non-nil if the Queue is empty.
+func (q *Queue[E]) Dequeue() (E, error) {
+	if q.IsEmpty() {
+		var e E
+		return e, fmt.Errorf("queue: %w", hold.ErrCollectionEmpty)
+	}
+	return q.deque.PopFront()
+}
+
+// Enqueue inserts the provided entry at the back of the Queue.
+func (q *Queue[E]) Enqueue(entry E) {
+	q.deque.PushBack(entry)
+}
+
+// IsEmpty returns true if the Queue contains no entries, otherwise false is returned.
+func (q *Queue[E]) IsEmpty() bool {
+	return q.deque.IsEmpty()
+}
+
+// Iterate returns the collection.Iterator for the Queue, which visits entries from the front to the back.
+func (q *Queue[E]) Iterate() hold.Iterator[E] {
+	return q.deque.Iterate()
+}
+
+// Len returns the number of entries in the Queue.
+func (q *Queue[E]) Len() int {
+	return q.deque.Len()
+}
+
+// Peek returns the entry at the front of the Queue without removing it.
+//
+// The returned error will be non-nil if the Queue is empty.
+func (q *Queue[E]) Peek() (E, error) {
+	if q.IsEmpty() {
+		var e E
+		return e, fmt.Errorf("queue: %w", hold.ErrCollectionEmpty)
+	}
+	return q.deque.PeekFront()
+}
+
+// Remove removes the first occurrence (if any), from the front, of an entry equivalent to the provided value.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (q *Queue[E]) Remove(value E) (bool, error) {
+	return q.deque.Remove(value)
+}
+
+// Values returns a slice containing the entries in the Queue in the iteration order, from the front to the back.
+func (q *Queue[E]) Values() []E {
+	return q.deque.Values()
+}
+
+// String returns a string representation of the Queue in it's current state, from the front to the back.
+func (q *Queue[E]) String() string {
+	return q.deque.String()
+}
@@ -0,0 +1,177 @@
+package queue
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueue(t *testing.T) {
+	q := Queue[string]{}
+
+	_, err := q.Dequeue()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	_, err = q.Peek()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	q.Enqueue("luffy")
+	q.Enqueue("zoro")
+	q.Enqueue("sanji")
+	assertContentEquals(t, &q, "[luffy, zoro, sanji]")
+
+	v, err := q.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "luffy", v)
+	assert.Equal(t, 3, q.Len())
+
+	for _, expected := range []string{"luffy", "zoro", "sanji"} {
+		v, err := q.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+	}
+	assert.True(t, q.IsEmpty())
+
+	t.Run("WrapAround", func(t *testing.T) {
+		q := New[int]()
+		for i := 0; i < 100; i++ {
+			q.Enqueue(i)
+			if i%3 == 0 {
+				_, err := q.Dequeue()
+				assert.NoError(t, err)
+			}
+		}
+
+		expected := 34
+		for !q.IsEmpty() {
+			v, err := q.Dequeue()
+			assert.NoError(t, err)
+			assert.Equal(t, expected, v)
+			expected++
+		}
+		assert.Equal(t, 100, expected)
+	})
+}
+
+func TestDeque(t *testing.T) {
+	d := Deque[int]{}
+
+	for _, fn := range []func() (int, error){d.PeekFront, d.PeekBack, d.PopFront, d.PopBack} {
+		_, err := fn()
+		assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+	}
+
+	for i := 0; i < 10; i++ {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	assertContentEquals(t, &d, "[-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9]")
+
+	front, err := d.PeekFront()
+	assert.NoError(t, err)
+	assert.Equal(t, -10, front)
+
+	back, err := d.PeekBack()
+	assert.NoError(t, err)
+	assert.Equal(t, 9, back)
+
+	for i := 0; i < 5; i++ {
+		v, err := d.PopFront()
+		assert.NoError(t, err)
+		assert.Equal(t, -10+i, v)
+
+		v, err = d.PopBack()
+		assert.NoError(t, err)
+		assert.Equal(t, 9-i, v)
+	}
+	assertContentEquals(t, &d, "[-5, -4, -3, -2, -1, 0, 1, 2, 3, 4]")
+	assert.Equal(t, 10, d.Len())
+}
+
+func TestDeque_Collection(t *testing.T) {
+	d := NewDeque(1, 2, 3)
+	d.PushFront(0)
+
+	err := d.AddAll(&list.List[int]{4, 2})
+	assert.NoError(t, err)
+	assertContentEquals(t, d, "[0, 1, 2, 3, 4, 2]")
+	assert.True(t, d.Contains(4))
+	assert.False(t, d.Contains(5))
+
+	r, err := d.Remove(2)
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assertContentEquals(t, d, "[0, 1, 3, 4, 2]")
+
+	r, err = d.Remove(5)
+	assert.NoError(t, err)
+	assert.False(t, r)
+
+	iter := d.Iterate()
+	var values []int
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{0, 1, 3, 4, 2}, values)
+
+	_, err = iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	d.Clear()
+	assert.True(t, d.IsEmpty())
+	assertContentEquals(t, d, "[]")
+
+	d.PushFront(7)
+	assertContentEquals(t, d, "[7]")
+}
+
+func BenchmarkQueue(b *testing.B) {
+	const size = 10000
+
+	b.Run("Queue", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			q := Queue[int]{}
+			for j := 0; j < size; j++ {
+				q.Enqueue(j)
+			}
+
+			for !q.IsEmpty() {
+				if _, err := q.Dequeue(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("List", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l := list.List[int]{}
+			for j := 0; j < size; j++ {
+				_ = l.AddLast(j)
+			}
+
+			for !l.IsEmpty() {
+				if _, err := l.RemoveFirst(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func assertContentEquals[E comparable](t *testing.T, collection hold.Collection[E], expected string) {
+	t.Helper()
+
+	actual := fmt.Sprintf("%s", collection)
+	if actual != expected {
+		t.Errorf("expected content of '%s', but found '%s'", expected, actual)
+	}
+}
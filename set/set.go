@@ -0,0 +1,194 @@
+package set
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/transientvariable/hold"
+)
+
+var _ hold.Collection[any] = (*Set[any])(nil)
+
+type iterator[E comparable] struct {
+	entries []E
+	index   int
+}
+
+func (i *iterator[E]) HasNext() bool {
+	return i.index < len(i.entries)
+}
+
+func (i *iterator[E]) Next() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("set_iter: %w", hold.ErrNoMoreElements)
+	}
+	n = i.entries[i.index]
+	i.index++
+	return n, nil
+}
+
+// Set is a basic implementation of a Collection that holds no duplicate entries.
+//
+// The iteration order of a Set is unspecified. This implementation does not make any guarantees for concurrent access.
+type Set[E comparable] map[E]struct{}
+
+// New creates a new Set containing the provided entries.
+func New[E comparable](entries ...E) *Set[E] {
+	s := make(Set[E], len(entries))
+	for _, e := range entries {
+		s[e] = struct{}{}
+	}
+	return &s
+}
+
+// Add inserts the provided entries into the Set. Entries that already exist in the Set are ignored.
+func (s *Set[E]) Add(entry ...E) error {
+	if *s == nil {
+		*s = make(Set[E], len(entry))
+	}
+
+	for _, e := range entry {
+		(*s)[e] = struct{}{}
+	}
+	return nil
+}
+
+// AddAll inserts all entries from the provided collection into the Set.
+func (s *Set[E]) AddAll(collection hold.Collection[E]) error {
+	if collection != nil {
+		return s.Add(collection.Values()...)
+	}
+	return nil
+}
+
+// Clear removes all entries from the Set.
+func (s *Set[E]) Clear() {
+	clear(*s)
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the Set, otherwise false is returned.
+func (s *Set[E]) Contains(value E) bool {
+	_, ok := (*s)[value]
+	return ok
+}
+
+// Difference returns a new Set containing the entries in the Set that do not exist in the provided Set.
+func (s *Set[E]) Difference(other *Set[E]) *Set[E] {
+	d := make(Set[E])
+	for e := range *s {
+		if other == nil || !other.Contains(e) {
+			d[e] = struct{}{}
+		}
+	}
+	return &d
+}
+
+// Intersection returns a new Set containing the entries that exist in both the Set and the provided Set.
+func (s *Set[E]) Intersection(other *Set[E]) *Set[E] {
+	i := make(Set[E])
+	if other == nil {
+		return &i
+	}
+
+	smaller, larger := s, other
+	if smaller.Len() > larger.Len() {
+		smaller, larger = larger, smaller
+	}
+
+	for e := range *smaller {
+		if larger.Contains(e) {
+			i[e] = struct{}{}
+		}
+	}
+	return &i
+}
+
+// IsEmpty returns true if the Set contains no entries, otherwise false is returned.
+func (s *Set[E]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// IsSubset returns true if every entry in the Set also exists in the provided Set, otherwise false is returned.
+//
+// The empty Set is a subset of every Set.
+func (s *Set[E]) IsSubset(other *Set[E]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+
+	for e := range *s {
+		if !other.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Iterate returns the collection.Iterator for the Set.
+//
+// The returned iterator visits the entries that were in the Set at the time of the call, and the order in which it
+// visits them does not change for the lifetime of the iterator.
+func (s *Set[E]) Iterate() hold.Iterator[E] {
+	return &iterator[E]{entries: s.Values()}
+}
+
+// Len returns the number of entries in the Set.
+func (s *Set[E]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(*s)
+}
+
+// Remove removes the entry equivalent to the provided value (if any).
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (s *Set[E]) Remove(value E) (bool, error) {
+	if !s.Contains(value) {
+		return false, nil
+	}
+	delete(*s, value)
+	return true, nil
+}
+
+// Union returns a new Set containing the entries that exist in either the Set or the provided Set.
+func (s *Set[E]) Union(other *Set[E]) *Set[E] {
+	u := make(Set[E], s.Len()+other.Len())
+	for e := range *s {
+		u[e] = struct{}{}
+	}
+
+	if other != nil {
+		for e := range *other {
+			u[e] = struct{}{}
+		}
+	}
+	return &u
+}
+
+// Values returns a slice containing the entries in the Set. The order of the entries is unspecified.
+func (s *Set[E]) Values() []E {
+	entries := make([]E, 0, s.Len())
+	for e := range *s {
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// String returns a string representation of the Set in it's current state.
+//
+// The entries are sorted by their string representation so that the output is deterministic.
+func (s *Set[E]) String() string {
+	if s.Len() == 0 {
+		return "[]"
+	}
+
+	entries := make([]string, 0, s.Len())
+	for e := range *s {
+		entries = append(entries, fmt.Sprintf("%v", e))
+	}
+	slices.Sort(entries)
+	return "[" + strings.Join(entries, ", ") + "]"
+}
@@ -0,0 +1,126 @@
+package set
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet_Add(t *testing.T) {
+	s := Set[string]{}
+
+	err := s.Add("luffy", "zoro", "luffy")
+	assert.NoError(t, err)
+	assertSize(t, &s, 2)
+
+	err = s.Add("zoro")
+	assert.NoError(t, err)
+	assertSize(t, &s, 2)
+	assertContentEquals(t, &s, "[luffy, zoro]")
+
+	err = s.AddAll(&list.List[string]{"sanji", "luffy", "sanji"})
+	assert.NoError(t, err)
+	assertSize(t, &s, 3)
+	assertContentEquals(t, &s, "[luffy, sanji, zoro]")
+
+	var zero Set[int]
+	err = zero.Add(1)
+	assert.NoError(t, err)
+	assert.True(t, zero.Contains(1))
+}
+
+func TestSet_Remove(t *testing.T) {
+	s := New("luffy", "zoro", "sanji")
+
+	r, err := s.Remove("zoro")
+	assert.NoError(t, err)
+	assert.True(t, r)
+
+	r, err = s.Remove("zoro")
+	assert.NoError(t, err)
+	assert.False(t, r)
+	assert.False(t, s.Contains("zoro"))
+	assertSize(t, s, 2)
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func TestSet_Iterate(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+
+	iter := s.Iterate()
+	var values []int
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		values = append(values, v)
+	}
+	assert.ElementsMatch(t, s.Values(), values)
+
+	_, err := iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+}
+
+func TestSet_Algebra(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	b := New(3, 4, 5)
+	disjoint := New(7, 8)
+	empty := New[int]()
+
+	t.Run("Union", func(t *testing.T) {
+		assertContentEquals(t, a.Union(b), "[1, 2, 3, 4, 5]")
+		assertContentEquals(t, a.Union(disjoint), "[1, 2, 3, 4, 7, 8]")
+		assertContentEquals(t, a.Union(empty), "[1, 2, 3, 4]")
+		assertContentEquals(t, empty.Union(empty), "[]")
+	})
+
+	t.Run("Intersection", func(t *testing.T) {
+		assertContentEquals(t, a.Intersection(b), "[3, 4]")
+		assertContentEquals(t, b.Intersection(a), "[3, 4]")
+		assertContentEquals(t, a.Intersection(disjoint), "[]")
+		assertContentEquals(t, a.Intersection(empty), "[]")
+	})
+
+	t.Run("Difference", func(t *testing.T) {
+		assertContentEquals(t, a.Difference(b), "[1, 2]")
+		assertContentEquals(t, b.Difference(a), "[5]")
+		assertContentEquals(t, a.Difference(disjoint), "[1, 2, 3, 4]")
+		assertContentEquals(t, empty.Difference(a), "[]")
+	})
+
+	t.Run("IsSubset", func(t *testing.T) {
+		assert.True(t, New(3, 4).IsSubset(a))
+		assert.True(t, a.IsSubset(a))
+		assert.True(t, empty.IsSubset(a))
+		assert.True(t, empty.IsSubset(empty))
+		assert.False(t, a.IsSubset(b))
+		assert.False(t, a.IsSubset(empty))
+		assert.False(t, disjoint.IsSubset(a))
+	})
+
+	assertContentEquals(t, a, "[1, 2, 3, 4]")
+	assertContentEquals(t, b, "[3, 4, 5]")
+}
+
+func assertContentEquals[E comparable](t *testing.T, collection hold.Collection[E], expected string) {
+	t.Helper()
+
+	actual := fmt.Sprintf("%s", collection)
+	if actual != expected {
+		t.Errorf("expected content of '%s', but found '%s'", expected, actual)
+	}
+}
+
+func assertSize[E comparable](t *testing.T, collection hold.Collection[E], expected int) {
+	t.Helper()
+
+	actual := collection.Len()
+	if actual != expected {
+		t.Errorf("expected size of '%d', but found '%d'", expected, actual)
+	}
+}
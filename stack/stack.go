@@ -0,0 +1,145 @@
+package stack
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+)
+
+var _ hold.Collection[any] = (*Stack[any])(nil)
+
+type iterator[E comparable] struct {
+	entries []E
+	index   int
+}
+
+func (i *iterator[E]) HasNext() bool {
+	return i.index < len(i.entries)
+}
+
+func (i *iterator[E]) Next() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("stack_iter: %w", hold.ErrNoMoreElements)
+	}
+	n = i.entries[i.index]
+	i.index++
+	return n, nil
+}
+
+// Stack is a last-in-first-out (LIFO) Collection backed by a List.
+//
+// The iteration order of a Stack is from the top (most recently pushed entry) to the bottom. This implementation does
+// not make any guarantees for concurrent access.
+type Stack[E comparable] struct {
+	entries list.List[E]
+}
+
+// New creates a new Stack and pushes the provided entries onto it in order, so the last entry provided is at the top.
+func New[E comparable](entries ...E) *Stack[E] {
+	s := &Stack[E]{}
+	_ = s.Add(entries...)
+	return s
+}
+
+// Add pushes the provided entries onto the Stack in order.
+func (s *Stack[E]) Add(entry ...E) error {
+	return s.entries.Add(entry...)
+}
+
+// AddAll pushes all entries from the provided collection onto the Stack in the iteration order of the collection.
+func (s *Stack[E]) AddAll(collection hold.Collection[E]) error {
+	return s.entries.AddAll(collection)
+}
+
+// Clear removes all entries from the Stack.
+func (s *Stack[E]) Clear() {
+	s.entries.Clear()
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the Stack, otherwise false is returned.
+func (s *Stack[E]) Contains(value E) bool {
+	return s.entries.Contains(value)
+}
+
+// IsEmpty returns true if the Stack contains no entries, otherwise false is returned.
+func (s *Stack[E]) IsEmpty() bool {
+	return s.entries.IsEmpty()
+}
+
+// Iterate returns the collection.Iterator for the Stack, which visits entries from the top to the bottom.
+func (s *Stack[E]) Iterate() hold.Iterator[E] {
+	return &iterator[E]{entries: s.Values()}
+}
+
+// Len returns the number of entries in the Stack.
+func (s *Stack[E]) Len() int {
+	return s.entries.Len()
+}
+
+// Peek returns the entry at the top of the Stack without removing it.
+//
+// The returned error will be non-nil if the Stack is empty.
+func (s *Stack[E]) Peek() (E, error) {
+	if s.IsEmpty() {
+		var e E
+		return e, fmt.Errorf("stack: %w", hold.ErrCollectionEmpty)
+	}
+	return s.entries.ValueAt(s.Len() - 1)
+}
+
+// Pop removes the entry at the top of the Stack and returns it.
+//
+// The returned error will be non-nil if the Stack is empty.
+func (s *Stack[E]) Pop() (E, error) {
+	if s.IsEmpty() {
+		var e E
+		return e, fmt.Errorf("stack: %w", hold.ErrCollectionEmpty)
+	}
+	return s.entries.RemoveLast()
+}
+
+// Push inserts the provided entry at the top of the Stack.
+func (s *Stack[E]) Push(entry E) {
+	_ = s.entries.AddLast(entry)
+}
+
+// Remove removes the occurrence (if any) of an entry equivalent to the provided value that is closest to the top of the
+// Stack.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (s *Stack[E]) Remove(value E) (bool, error) {
+	for i := s.Len() - 1; i >= 0; i-- {
+		if reflect.DeepEqual(s.entries[i], value) {
+			if _, err := s.entries.RemoveAt(i); err != nil {
+				return false, err
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Values returns a slice containing the entries in the Stack in the iteration order, from the top to the bottom.
+func (s *Stack[E]) Values() []E {
+	entries := s.entries.Values()
+	slices.Reverse(entries)
+	return entries
+}
+
+// String returns a string representation of the Stack in it's current state, from the top to the bottom.
+func (s *Stack[E]) String() string {
+	if s.Len() == 0 {
+		return "[]"
+	}
+
+	entries := make([]string, 0, s.Len())
+	for _, e := range s.Values() {
+		entries = append(entries, fmt.Sprintf("%v", e))
+	}
+	return "[" + strings.Join(entries, ", ") + "]"
+}
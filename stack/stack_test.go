@@ -0,0 +1,93 @@
+package stack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStack_PushPop(t *testing.T) {
+	s := Stack[string]{}
+	s.Push("luffy")
+	s.Push("zoro")
+	s.Push("sanji")
+	assertContentEquals(t, &s, "[sanji, zoro, luffy]")
+	assert.Equal(t, 3, s.Len())
+
+	for _, expected := range []string{"sanji", "zoro", "luffy"} {
+		v, err := s.Pop()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+	}
+	assert.True(t, s.IsEmpty())
+
+	_, err := s.Pop()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+}
+
+func TestStack_Peek(t *testing.T) {
+	s := New[int]()
+
+	_, err := s.Peek()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	s.Push(1)
+	s.Push(2)
+
+	for i := 0; i < 3; i++ {
+		v, err := s.Peek()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, v)
+	}
+	assert.Equal(t, 2, s.Len())
+}
+
+func TestStack_Collection(t *testing.T) {
+	s := New(1, 2, 3)
+	assertContentEquals(t, s, "[3, 2, 1]")
+
+	err := s.AddAll(&list.List[int]{4, 5})
+	assert.NoError(t, err)
+	assertContentEquals(t, s, "[5, 4, 3, 2, 1]")
+
+	iter := s.Iterate()
+	var values []int
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, values)
+
+	_, err = iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	err = s.Add(3)
+	assert.NoError(t, err)
+	assert.True(t, s.Contains(3))
+
+	r, err := s.Remove(3)
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assertContentEquals(t, s, "[5, 4, 3, 2, 1]")
+
+	r, err = s.Remove(9)
+	assert.NoError(t, err)
+	assert.False(t, r)
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func assertContentEquals[E comparable](t *testing.T, collection hold.Collection[E], expected string) {
+	t.Helper()
+
+	actual := fmt.Sprintf("%s", collection)
+	if actual != expected {
+		t.Errorf("expected content of '%s', but found '%s'", expected, actual)
+	}
+}
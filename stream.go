@@ -0,0 +1,361 @@
+package hold
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// Filter returns a slice containing the entries of the provided Collection, in iteration order, for which the provided
+// predicate returns true.
+//
+// If the Iterator of the Collection returns an error, iteration stops and the error is returned along with the entries
+// matched so far.
+func Filter[E comparable](c Collection[E], pred func(E) bool) ([]E, error) {
+	var entries []E
+	err := each(c, func(e E) {
+		if pred(e) {
+			entries = append(entries, e)
+		}
+	})
+	return entries, err
+}
+
+// Chunk returns an Iterator that lazily visits the entries of the provided Iterator in chunks of the provided size, in
+// iteration order. Each chunk holds the provided number of entries, except for the last chunk, which holds the
+// remaining entries if there are fewer.
+//
+// Entries are only read from the provided Iterator when Next is called on the returned Iterator. If the provided
+// Iterator returns an error, Next returns the error along with the entries read for the chunk so far. The returned
+// error will be non-nil if the provided size is less than or equal to zero.
+func Chunk[E any](it Iterator[E], size int) (Iterator[[]E], error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("chunk_iter: chunk size must be greater than 0: %d", size)
+	}
+	return &chunkIterator[E]{iter: it, size: size}, nil
+}
+
+type chunkIterator[E any] struct {
+	iter Iterator[E]
+	size int
+}
+
+func (i *chunkIterator[E]) HasNext() bool {
+	return i.iter.HasNext()
+}
+
+func (i *chunkIterator[E]) Next() ([]E, error) {
+	if !i.HasNext() {
+		return nil, fmt.Errorf("chunk_iter: %w", ErrNoMoreElements)
+	}
+
+	chunk := make([]E, 0, i.size)
+	for len(chunk) < i.size && i.iter.HasNext() {
+		e, err := i.iter.Next()
+		if err != nil {
+			return chunk, err
+		}
+		chunk = append(chunk, e)
+	}
+	return chunk, nil
+}
+
+// CopyTo adds each entry of the provided source Collection to the provided destination Collection, one entry at a time
+// in the iteration order of the source, such as to collect the values of a Trie into a List.
+//
+// Copying stops at the first non-nil error returned by the Add method of the destination, such as when a bounded
+// Collection has reached capacity, or by the Iterator of the source, and that error is returned. The entries added
+// before the error remain in the destination.
+func CopyTo[E comparable](src Collection[E], dst Collection[E]) error {
+	return ForEach(src, func(e E) error {
+		return dst.Add(e)
+	})
+}
+
+// FilterIterator returns an Iterator that lazily visits the entries of the provided Iterator for which the provided
+// predicate returns true.
+//
+// Entries are only read from the provided Iterator, and passed to the predicate, when HasNext or Next is called on the
+// returned Iterator, and only as far as the next matching entry. An error returned by the provided Iterator is returned
+// by the following call to Next.
+func FilterIterator[E any](it Iterator[E], pred func(E) bool) Iterator[E] {
+	return &filterIterator[E]{iter: it, pred: pred}
+}
+
+type filterIterator[E any] struct {
+	err   error
+	found bool
+	iter  Iterator[E]
+	next  E
+	pred  func(E) bool
+}
+
+// HasNext advances the underlying Iterator to the next entry that matches the predicate, if it has not done so
+// already.
+func (i *filterIterator[E]) HasNext() bool {
+	for !i.found && i.err == nil && i.iter.HasNext() {
+		e, err := i.iter.Next()
+		if err != nil {
+			i.err = err
+			break
+		}
+
+		if i.pred(e) {
+			i.next = e
+			i.found = true
+		}
+	}
+	return i.found || i.err != nil
+}
+
+func (i *filterIterator[E]) Next() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("filter_iter: %w", ErrNoMoreElements)
+	}
+
+	if i.err != nil {
+		err := i.err
+		i.err = nil
+		return n, err
+	}
+
+	i.found = false
+	return i.next, nil
+}
+
+// ForEach calls the provided function for each entry of the provided Collection in iteration order.
+//
+// Iteration stops at the first non-nil error returned by the function or by the Iterator of the Collection, and that
+// error is returned.
+func ForEach[E comparable](c Collection[E], fn func(E) error) error {
+	if c == nil {
+		return nil
+	}
+
+	iter := c.Iterate()
+	for iter.HasNext() {
+		e, err := iter.Next()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Map returns a slice containing the result of applying the provided function to each entry of the provided
+// Collection, in iteration order.
+//
+// Unlike Collection.Values, Map can collect the entries into a slice of a different type, such as a projected field of
+// each entry or []any. If the Iterator of the Collection returns an error, iteration stops and the error is returned
+// along with the results collected so far.
+func Map[E comparable, R any](c Collection[E], fn func(E) R) ([]R, error) {
+	var results []R
+	if c != nil {
+		results = make([]R, 0, c.Len())
+	}
+
+	err := each(c, func(e E) {
+		results = append(results, fn(e))
+	})
+	return results, err
+}
+
+// MapIterator returns an Iterator that lazily visits the result of applying the provided function to each entry of the
+// provided Iterator, in iteration order.
+//
+// The function is only applied to an entry when it is read from the returned Iterator by a call to Next. An error
+// returned by the provided Iterator is returned by Next without applying the function.
+func MapIterator[E any, R any](it Iterator[E], fn func(E) R) Iterator[R] {
+	return &mapIterator[E, R]{iter: it, fn: fn}
+}
+
+type mapIterator[E any, R any] struct {
+	fn   func(E) R
+	iter Iterator[E]
+}
+
+func (i *mapIterator[E, R]) HasNext() bool {
+	return i.iter.HasNext()
+}
+
+func (i *mapIterator[E, R]) Next() (R, error) {
+	var r R
+	if !i.HasNext() {
+		return r, fmt.Errorf("map_iter: %w", ErrNoMoreElements)
+	}
+
+	e, err := i.iter.Next()
+	if err != nil {
+		return r, err
+	}
+	return i.fn(e), nil
+}
+
+// Merge returns an Iterator that lazily performs a k-way merge of the entries of the provided iterators, each of which
+// is expected to visit its entries in the order defined by the provided comparator, so that the returned Iterator
+// visits the entries of all the iterators in that order.
+//
+// The comparator should return a negative number when a < b, a positive number when a > b, and zero when a == b.
+// Entries that compare equal are visited in the order of the iterators they were read from. Only the next entry of
+// each of the provided iterators is held at a time, and iterators that have no more entries are skipped. An error
+// returned by any of the provided iterators is returned by the following call to Next.
+func Merge[E any](cmp func(a, b E) int, iters ...Iterator[E]) Iterator[E] {
+	return &mergeIterator[E]{heads: mergeHeap[E]{cmp: cmp}, iters: iters}
+}
+
+type mergeIterator[E any] struct {
+	err    error
+	heads  mergeHeap[E]
+	iters  []Iterator[E]
+	primed bool
+}
+
+// HasNext reads the first entry of each of the underlying iterators, if it has not done so already.
+func (i *mergeIterator[E]) HasNext() bool {
+	if !i.primed {
+		i.primed = true
+		for index := range i.iters {
+			i.advance(index)
+		}
+	}
+	return i.heads.Len() > 0 || i.err != nil
+}
+
+func (i *mergeIterator[E]) Next() (E, error) {
+	var n E
+	if !i.HasNext() {
+		return n, fmt.Errorf("merge_iter: %w", ErrNoMoreElements)
+	}
+
+	if i.err != nil {
+		err := i.err
+		i.err = nil
+		return n, err
+	}
+
+	c := heap.Pop(&i.heads).(mergeCursor[E])
+	i.advance(c.index)
+	return c.entry, nil
+}
+
+// advance reads the next entry (if any) of the underlying Iterator at the provided index into the heap of heads.
+func (i *mergeIterator[E]) advance(index int) {
+	if i.err != nil || !i.iters[index].HasNext() {
+		return
+	}
+
+	e, err := i.iters[index].Next()
+	if err != nil {
+		i.err = err
+		return
+	}
+	heap.Push(&i.heads, mergeCursor[E]{entry: e, index: index})
+}
+
+// mergeCursor holds the next entry of an Iterator being merged, along with the position of the Iterator.
+type mergeCursor[E any] struct {
+	entry E
+	index int
+}
+
+// mergeHeap implements heap.Interface for the cursors of the iterators being merged, ordered by their entries and then
+// by the positions of their iterators.
+type mergeHeap[E any] struct {
+	cmp     func(a, b E) int
+	cursors []mergeCursor[E]
+}
+
+func (h *mergeHeap[E]) Len() int {
+	return len(h.cursors)
+}
+
+func (h *mergeHeap[E]) Less(i, j int) bool {
+	if c := h.cmp(h.cursors[i].entry, h.cursors[j].entry); c != 0 {
+		return c < 0
+	}
+	return h.cursors[i].index < h.cursors[j].index
+}
+
+func (h *mergeHeap[E]) Swap(i, j int) {
+	h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i]
+}
+
+func (h *mergeHeap[E]) Push(x any) {
+	h.cursors = append(h.cursors, x.(mergeCursor[E]))
+}
+
+func (h *mergeHeap[E]) Pop() any {
+	last := len(h.cursors) - 1
+	c := h.cursors[last]
+	h.cursors[last] = mergeCursor[E]{}
+	h.cursors = h.cursors[:last]
+	return c
+}
+
+// Reduce combines the entries of the provided Collection, in iteration order, into a single value by successively
+// applying the provided function to the accumulated value and each entry, starting with init.
+//
+// If the Iterator of the Collection returns an error, iteration stops and the error is returned along with the value
+// accumulated so far.
+func Reduce[E comparable, A any](c Collection[E], init A, fn func(A, E) A) (A, error) {
+	acc := init
+	err := each(c, func(e E) {
+		acc = fn(acc, e)
+	})
+	return acc, err
+}
+
+// Pair holds two entries, such as those visited together by the Iterator returned by Zip.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip returns an Iterator that lazily visits the entries of the provided iterators pairwise, in iteration order, until
+// either of the iterators has no more entries.
+//
+// Any entries remaining in the longer of the iterators are not visited. An error returned by either of the provided
+// iterators is returned by Next.
+func Zip[A any, B any](a Iterator[A], b Iterator[B]) Iterator[Pair[A, B]] {
+	return &zipIterator[A, B]{a: a, b: b}
+}
+
+type zipIterator[A any, B any] struct {
+	a Iterator[A]
+	b Iterator[B]
+}
+
+func (i *zipIterator[A, B]) HasNext() bool {
+	return i.a.HasNext() && i.b.HasNext()
+}
+
+func (i *zipIterator[A, B]) Next() (Pair[A, B], error) {
+	var p Pair[A, B]
+	if !i.HasNext() {
+		return p, fmt.Errorf("zip_iter: %w", ErrNoMoreElements)
+	}
+
+	a, err := i.a.Next()
+	if err != nil {
+		return p, err
+	}
+
+	b, err := i.b.Next()
+	if err != nil {
+		return p, err
+	}
+	return Pair[A, B]{First: a, Second: b}, nil
+}
+
+// each calls the provided function for each entry of the provided Collection in iteration order, and returns the first
+// error returned by the Iterator of the Collection.
+func each[E comparable](c Collection[E], fn func(E)) error {
+	return ForEach(c, func(e E) error {
+		fn(e)
+		return nil
+	})
+}
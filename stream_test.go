@@ -0,0 +1,401 @@
+package hold_test
+
+import (
+	"cmp"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+	"github.com/transientvariable/hold/trie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	collect := func(c hold.Collection[int], size int) [][]int {
+		iter, err := hold.Chunk(c.Iterate(), size)
+		assert.NoError(t, err)
+
+		var chunks [][]int
+		for iter.HasNext() {
+			chunk, err := iter.Next()
+			assert.NoError(t, err)
+			chunks = append(chunks, chunk)
+		}
+
+		_, err = iter.Next()
+		assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+		return chunks
+	}
+
+	l := list.List[int]{1, 2, 3, 4, 5, 6}
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}}, collect(&l, 3))
+	assert.Equal(t, [][]int{{1, 2, 3, 4}, {5, 6}}, collect(&l, 4))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5, 6}}, collect(&l, 10))
+	assert.Equal(t, [][]int{{1}, {2}, {3}, {4}, {5}, {6}}, collect(&l, 1))
+	assert.Empty(t, collect(&list.List[int]{}, 3))
+
+	for _, size := range []int{0, -1} {
+		iter, err := hold.Chunk(l.Iterate(), size)
+		assert.Error(t, err)
+		assert.Nil(t, iter)
+	}
+
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("zoro", "luffy", "nami", "sanji", "usopp"))
+
+	iter, err := hold.Chunk(tr.Iterate(), 2)
+	assert.NoError(t, err)
+
+	lengths := hold.MapIterator(iter, func(chunk []string) int { return len(chunk) })
+	var sizes []int
+	for lengths.HasNext() {
+		n, err := lengths.Next()
+		assert.NoError(t, err)
+		sizes = append(sizes, n)
+	}
+	assert.Equal(t, []int{2, 2, 1}, sizes)
+}
+
+func TestCopyTo(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("zoro", "luffy", "nami", "sanji"))
+
+	l := list.List[string]{"usopp"}
+	assert.NoError(t, hold.CopyTo[string](tr, &l))
+	assert.Equal(t, list.List[string]{"usopp", "luffy", "nami", "sanji", "zoro"}, l)
+
+	b := list.NewBounded[string](3)
+	assert.ErrorIs(t, hold.CopyTo[string](tr, b), hold.ErrCapacityReached)
+	assert.Equal(t, []string{"luffy", "nami", "sanji"}, b.Values())
+
+	empty := list.List[string]{}
+	assert.NoError(t, hold.CopyTo[string](&empty, b))
+	assert.NoError(t, hold.CopyTo[string](nil, &l))
+	assert.Equal(t, 5, l.Len())
+}
+
+func TestFilter(t *testing.T) {
+	l := list.List[int]{1, 2, 3, 4, 5, 6}
+
+	even, err := hold.Filter[int](&l, func(e int) bool { return e%2 == 0 })
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4, 6}, even)
+
+	large, err := hold.Filter[int](&l, func(e int) bool { return e > 6 })
+	assert.NoError(t, err)
+	assert.Empty(t, large)
+
+	all, err := hold.Filter[int](&list.List[int]{}, func(e int) bool { return true })
+	assert.NoError(t, err)
+	assert.Empty(t, all)
+
+	even, err = hold.Filter[int](&failingCollection{List: l, limit: 4}, func(e int) bool { return e%2 == 0 })
+	assert.ErrorIs(t, err, errIterate)
+	assert.Equal(t, []int{2, 4}, even)
+}
+
+func TestFilterIterator(t *testing.T) {
+	l := list.List[int]{1, 2, 3, 4, 5, 6, 7, 8}
+
+	var calls int
+	iter := hold.FilterIterator(l.Iterate(), func(e int) bool {
+		calls++
+		return e%3 == 0
+	})
+	assert.Equal(t, 0, calls)
+
+	assert.True(t, iter.HasNext())
+	assert.Equal(t, 3, calls)
+	assert.True(t, iter.HasNext())
+	assert.Equal(t, 3, calls)
+
+	e, err := iter.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, e)
+	assert.Equal(t, 3, calls)
+
+	e, err = iter.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 6, e)
+	assert.Equal(t, 6, calls)
+
+	assert.False(t, iter.HasNext())
+	assert.Equal(t, 8, calls)
+
+	_, err = iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("luffy", "zoro", "nami", "sanji", "nico robin"))
+
+	var matches []string
+	iter2 := hold.FilterIterator(tr.Iterate(), func(e string) bool { return strings.Contains(e, "n") })
+	for iter2.HasNext() {
+		e, err := iter2.Next()
+		assert.NoError(t, err)
+		matches = append(matches, e)
+	}
+	assert.Equal(t, []string{"nami", "nico robin", "sanji"}, matches)
+}
+
+func TestForEach(t *testing.T) {
+	l := list.List[int]{1, 2, 3, 4, 5}
+
+	var visited []int
+	err := hold.ForEach[int](&l, func(e int) error {
+		visited = append(visited, e)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, visited)
+
+	errStop := errors.New("stop")
+	visited = nil
+	err = hold.ForEach[int](&l, func(e int) error {
+		visited = append(visited, e)
+		if e == 3 {
+			return errStop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, []int{1, 2, 3}, visited)
+
+	assert.NoError(t, hold.ForEach[int](nil, func(e int) error { return errStop }))
+}
+
+func TestMap(t *testing.T) {
+	l := list.List[string]{"luffy", "zoro", "sanji"}
+
+	lengths, err := hold.Map[string](&l, func(e string) int { return len(e) })
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5, 4, 5}, lengths)
+
+	upper, err := hold.Map[string](&list.List[string]{}, strings.ToUpper)
+	assert.NoError(t, err)
+	assert.Empty(t, upper)
+
+	doubled, err := hold.Map[int](&failingCollection{List: list.List[int]{1, 2, 3}, limit: 2}, func(e int) int { return e * 2 })
+	assert.ErrorIs(t, err, errIterate)
+	assert.Equal(t, []int{2, 4}, doubled)
+}
+
+func TestMap_Trie(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+
+	for _, e := range []trie.Entry{trie.NewEntry("zoro", 2), trie.NewEntry("luffy", 1), trie.NewEntry("sanji", 3)} {
+		assert.NoError(t, tr.AddEntry(e))
+	}
+
+	upper, err := hold.Map[string](tr, strings.ToUpper)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"LUFFY", "SANJI", "ZORO"}, upper)
+
+	entries, err := tr.Entries()
+	assert.NoError(t, err)
+
+	l := list.List[trie.Entry](entries)
+	data, err := hold.Map[trie.Entry](&l, trie.Entry.Data)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{1, 3, 2}, data)
+}
+
+func TestMapIterator(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("luffy", "zoro", "nami", "nico robin"))
+
+	var calls int
+	iter := hold.MapIterator(tr.Iterate(), func(e string) int {
+		calls++
+		return len(e)
+	})
+	assert.Equal(t, 0, calls)
+
+	var lengths []int
+	for iter.HasNext() {
+		e, err := iter.Next()
+		assert.NoError(t, err)
+		lengths = append(lengths, e)
+		assert.Equal(t, len(lengths), calls)
+	}
+	assert.Equal(t, []int{5, 4, 10, 4}, lengths)
+
+	_, err = iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	type player struct {
+		name   string
+		length int
+	}
+
+	l := list.List[string]{"zoro", "luffy"}
+	players := hold.MapIterator(l.Iterate(), func(e string) player { return player{name: e, length: len(e)} })
+	p, err := players.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, player{name: "zoro", length: 4}, p)
+}
+
+func TestMerge(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("nami", "chopper", "zoro"))
+
+	a := list.List[string]{"brook", "luffy", "sanji"}
+	b := list.List[string]{"franky", "luffy", "robin", "usopp", "vivi"}
+
+	collect := func(iter hold.Iterator[string]) []string {
+		var values []string
+		for iter.HasNext() {
+			e, err := iter.Next()
+			assert.NoError(t, err)
+			values = append(values, e)
+		}
+
+		_, err := iter.Next()
+		assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+		return values
+	}
+
+	assert.Equal(t, []string{
+		"brook", "chopper", "franky", "luffy", "luffy", "nami", "robin", "sanji", "usopp", "vivi", "zoro",
+	}, collect(hold.Merge(cmp.Compare[string], a.Iterate(), tr.Iterate(), b.Iterate())))
+
+	empty := list.List[string]{}
+	merged := hold.Merge(cmp.Compare[string], empty.Iterate(), a.Iterate(), empty.Iterate())
+	assert.Equal(t, []string{"brook", "luffy", "sanji"}, collect(merged))
+	assert.Empty(t, collect(hold.Merge(cmp.Compare[string], empty.Iterate())))
+	assert.Empty(t, collect(hold.Merge[string](cmp.Compare[string])))
+
+	x := list.List[int]{9, 4, 1}
+	y := list.List[int]{8, 7, 6, 5, 3, 2}
+	iter := hold.Merge(func(a, b int) int { return cmp.Compare(b, a) }, x.Iterate(), y.Iterate())
+
+	var values []int
+	for iter.HasNext() {
+		e, err := iter.Next()
+		assert.NoError(t, err)
+		values = append(values, e)
+	}
+	assert.Equal(t, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}, values)
+}
+
+func TestReduce(t *testing.T) {
+	l := list.List[int]{1, 2, 3, 4}
+
+	sum, err := hold.Reduce[int](&l, 0, func(acc int, e int) int { return acc + e })
+	assert.NoError(t, err)
+	assert.Equal(t, 10, sum)
+
+	s, err := hold.Reduce[int](&list.List[int]{}, "init", func(acc string, e int) string { return acc + "!" })
+	assert.NoError(t, err)
+	assert.Equal(t, "init", s)
+
+	sum, err = hold.Reduce[int](&failingCollection{List: l, limit: 3}, 0, func(acc int, e int) int { return acc + e })
+	assert.ErrorIs(t, err, errIterate)
+	assert.Equal(t, 6, sum)
+}
+
+func TestFilterMap_TrieCompletions(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+
+	err = tr.Add("dab", "dabble", "dace", "dad", "daddy", "dog")
+	assert.NoError(t, err)
+
+	completions := list.List[string]{}
+	err = tr.Completions("da", &completions)
+	assert.NoError(t, err)
+
+	filtered, err := hold.Filter[string](&completions, func(e string) bool { return len(e) > 3 })
+	assert.NoError(t, err)
+
+	long := list.List[string](filtered)
+	upper, err := hold.Map[string](&long, strings.ToUpper)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"DABBLE", "DACE", "DADDY"}, upper)
+
+	n, err := hold.Reduce[string](tr, 0, func(acc int, e string) int {
+		if strings.HasPrefix(e, "dab") || strings.HasPrefix(e, "dog") {
+			return acc + 1
+		}
+		return acc
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
+
+func TestZip(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("zoro", "luffy", "nami"))
+
+	collect := func(iter hold.Iterator[hold.Pair[string, int]]) []hold.Pair[string, int] {
+		var pairs []hold.Pair[string, int]
+		for iter.HasNext() {
+			p, err := iter.Next()
+			assert.NoError(t, err)
+			pairs = append(pairs, p)
+		}
+
+		_, err := iter.Next()
+		assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+		return pairs
+	}
+
+	bounties := list.List[int]{3000, 366, 1}
+	assert.Equal(t, []hold.Pair[string, int]{
+		{First: "luffy", Second: 3000},
+		{First: "nami", Second: 366},
+		{First: "zoro", Second: 1},
+	}, collect(hold.Zip(tr.Iterate(), bounties.Iterate())))
+
+	short := list.List[int]{3000, 366}
+	assert.Equal(t, []hold.Pair[string, int]{
+		{First: "luffy", Second: 3000},
+		{First: "nami", Second: 366},
+	}, collect(hold.Zip(tr.Iterate(), short.Iterate())))
+
+	long := list.List[int]{1, 2, 3, 4, 5}
+	assert.Len(t, collect(hold.Zip(tr.Iterate(), long.Iterate())), 3)
+
+	assert.Empty(t, collect(hold.Zip(tr.Iterate(), (&list.List[int]{}).Iterate())))
+}
+
+var errIterate = errors.New("iterate failed")
+
+// failingCollection is a List whose Iterator returns errIterate after visiting the provided number of entries.
+type failingCollection struct {
+	list.List[int]
+	limit int
+}
+
+func (c *failingCollection) Iterate() hold.Iterator[int] {
+	return &failingIterator{entries: c.Values(), limit: c.limit}
+}
+
+type failingIterator struct {
+	entries []int
+	index   int
+	limit   int
+}
+
+func (i *failingIterator) HasNext() bool {
+	return i.index < len(i.entries)
+}
+
+func (i *failingIterator) Next() (int, error) {
+	if i.index == i.limit {
+		return 0, errIterate
+	}
+	e := i.entries[i.index]
+	i.index++
+	return e, nil
+}
@@ -0,0 +1,401 @@
+package treemap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/transientvariable/hold"
+)
+
+var _ hold.Ordered[any] = (*TreeMap[any, any])(nil)
+
+type iterator[K comparable] struct {
+	entries []K
+	index   int
+}
+
+func (i *iterator[K]) HasNext() bool {
+	return i.index < len(i.entries)
+}
+
+func (i *iterator[K]) Next() (K, error) {
+	var n K
+	if !i.HasNext() {
+		return n, fmt.Errorf("treemap_iter: %w", hold.ErrNoMoreElements)
+	}
+	n = i.entries[i.index]
+	i.index++
+	return n, nil
+}
+
+type node[K comparable, V any] struct {
+	height int
+	key    K
+	left   *node[K, V]
+	right  *node[K, V]
+	value  V
+}
+
+// TreeMap is an implementation of an Ordered collection of keys, each of which is associated with a value, that is
+// backed by a self-balancing (AVL) binary search tree.
+//
+// Keys are positioned by the comparator provided to New, so keys of any comparable type can be kept in order, and
+// lookups, insertions, and removals take logarithmic time. As a Collection, a TreeMap holds its keys: Add inserts keys
+// with the zero value, and Values returns the keys in iteration order. Use Put, Get, and Entries to work with the
+// associated values. This implementation does not make any guarantees for concurrent access.
+type TreeMap[K comparable, V any] struct {
+	cmp  func(a, b K) int
+	root *node[K, V]
+	size int
+}
+
+// New creates a new, empty TreeMap whose keys are ordered by the provided comparator.
+//
+// The comparator should return a negative number when a < b, a positive number when a > b, and zero when a == b. Keys
+// for which the comparator returns zero are considered equivalent.
+func New[K comparable, V any](cmp func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{cmp: cmp}
+}
+
+// Add inserts the provided keys into the TreeMap, each associated with the zero value. Keys that already exist in the
+// TreeMap keep their associated value.
+func (t *TreeMap[K, V]) Add(key ...K) error {
+	var v V
+	for _, k := range key {
+		t.root = t.insert(t.root, k, v, false)
+	}
+	return nil
+}
+
+// AddAll inserts all keys from the provided collection into the TreeMap, each associated with the zero value.
+func (t *TreeMap[K, V]) AddAll(collection hold.Collection[K]) error {
+	if collection != nil {
+		return t.Add(collection.Values()...)
+	}
+	return nil
+}
+
+// Clear removes all keys from the TreeMap.
+func (t *TreeMap[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Contains returns true if a key equivalent to the provided key exists in the TreeMap, otherwise false is returned.
+func (t *TreeMap[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+// Entries calls the provided function with each key in the TreeMap and its associated value in iteration order.
+//
+// Iteration stops at the first non-nil error returned by the function, and that error is returned.
+func (t *TreeMap[K, V]) Entries(fn func(key K, value V) error) error {
+	return walk(t.root, fn)
+}
+
+// Get returns the value associated with the key equivalent to the provided key and true. If the TreeMap does not
+// contain such a key, the zero value and false are returned.
+func (t *TreeMap[K, V]) Get(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.value, true
+	}
+
+	var v V
+	return v, false
+}
+
+// IsEmpty returns true if the TreeMap contains no keys, otherwise false is returned.
+func (t *TreeMap[K, V]) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Iterate returns the collection.Iterator for the keys in the TreeMap.
+//
+// The returned iterator visits the keys that were in the TreeMap at the time of the call in ascending order.
+func (t *TreeMap[K, V]) Iterate() hold.Iterator[K] {
+	return &iterator[K]{entries: t.Values()}
+}
+
+// Len returns the number of keys in the TreeMap.
+func (t *TreeMap[K, V]) Len() int {
+	return t.size
+}
+
+// Max returns the greatest key in the TreeMap, which will be the last key in the iteration order.
+//
+// The returned error will be non-nil if the TreeMap is empty (has no elements).
+func (t *TreeMap[K, V]) Max() (K, error) {
+	if t.root == nil {
+		var k K
+		return k, fmt.Errorf("treemap: %w", hold.ErrCollectionEmpty)
+	}
+
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, nil
+}
+
+// Min returns the least key in the TreeMap, which will be the first key in the iteration order.
+//
+// The returned error will be non-nil if the TreeMap is empty (has no elements).
+func (t *TreeMap[K, V]) Min() (K, error) {
+	if t.root == nil {
+		var k K
+		return k, fmt.Errorf("treemap: %w", hold.ErrCollectionEmpty)
+	}
+
+	n := t.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, nil
+}
+
+// Predecessor returns the greatest key in the TreeMap that is less than the provided key.
+//
+// The returned error will be non-nil if:
+//   - the TreeMap is empty (has no elements)
+//   - the TreeMap does not contain a key equivalent to the provided key
+//   - the provided key is the least key in the TreeMap
+func (t *TreeMap[K, V]) Predecessor(key K) (K, error) {
+	var p *node[K, V]
+	n, err := t.search(key, func(n *node[K, V]) { p = n }, nil)
+	if err != nil {
+		return key, err
+	}
+
+	if n.left != nil {
+		p = n.left
+		for p.right != nil {
+			p = p.right
+		}
+	}
+
+	if p == nil {
+		return key, fmt.Errorf("treemap: %w", hold.ErrNotFound)
+	}
+	return p.key, nil
+}
+
+// Put associates the provided value with the provided key, inserting the key if the TreeMap does not contain an
+// equivalent key, or replacing the value associated with the existing key otherwise.
+func (t *TreeMap[K, V]) Put(key K, value V) {
+	t.root = t.insert(t.root, key, value, true)
+}
+
+// Remove removes the key equivalent to the provided key (if any), along with its associated value.
+//
+// If a key was removed, the return value will be true, otherwise false will be returned.
+func (t *TreeMap[K, V]) Remove(key K) (bool, error) {
+	var removed bool
+	t.root = t.remove(t.root, key, &removed)
+	if removed {
+		t.size--
+	}
+	return removed, nil
+}
+
+// Successor returns the least key in the TreeMap that is greater than the provided key.
+//
+// The returned error will be non-nil if:
+//   - the TreeMap is empty (has no elements)
+//   - the TreeMap does not contain a key equivalent to the provided key
+//   - the provided key is the greatest key in the TreeMap
+func (t *TreeMap[K, V]) Successor(key K) (K, error) {
+	var s *node[K, V]
+	n, err := t.search(key, nil, func(n *node[K, V]) { s = n })
+	if err != nil {
+		return key, err
+	}
+
+	if n.right != nil {
+		s = n.right
+		for s.left != nil {
+			s = s.left
+		}
+	}
+
+	if s == nil {
+		return key, fmt.Errorf("treemap: %w", hold.ErrNotFound)
+	}
+	return s.key, nil
+}
+
+// Values returns a slice containing the keys in the TreeMap in ascending order.
+func (t *TreeMap[K, V]) Values() []K {
+	keys := make([]K, 0, t.size)
+	_ = walk(t.root, func(k K, _ V) error {
+		keys = append(keys, k)
+		return nil
+	})
+	return keys
+}
+
+// String returns a string representation of the TreeMap in it's current state, listing each key and its associated
+// value in iteration order.
+func (t *TreeMap[K, V]) String() string {
+	entries := make([]string, 0, t.size)
+	_ = walk(t.root, func(k K, v V) error {
+		entries = append(entries, fmt.Sprintf("%v:%v", k, v))
+		return nil
+	})
+	return "[" + strings.Join(entries, ", ") + "]"
+}
+
+func (t *TreeMap[K, V]) find(key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (t *TreeMap[K, V]) insert(n *node[K, V], key K, value V, overwrite bool) *node[K, V] {
+	if n == nil {
+		t.size++
+		return &node[K, V]{height: 1, key: key, value: value}
+	}
+
+	c := t.cmp(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.insert(n.left, key, value, overwrite)
+	case c > 0:
+		n.right = t.insert(n.right, key, value, overwrite)
+	default:
+		if overwrite {
+			n.value = value
+		}
+		return n
+	}
+	return rebalance(n)
+}
+
+func (t *TreeMap[K, V]) remove(n *node[K, V], key K, removed *bool) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	c := t.cmp(key, n.key)
+	switch {
+	case c < 0:
+		n.left = t.remove(n.left, key, removed)
+	case c > 0:
+		n.right = t.remove(n.right, key, removed)
+	default:
+		*removed = true
+		if n.left == nil {
+			return n.right
+		}
+
+		if n.right == nil {
+			return n.left
+		}
+
+		// Replace the node with its successor, which is then removed from the right subtree in its place.
+		s := n.right
+		for s.left != nil {
+			s = s.left
+		}
+		n.key, n.value = s.key, s.value
+		n.right = t.remove(n.right, s.key, new(bool))
+	}
+	return rebalance(n)
+}
+
+// search descends from the root to the node holding the key equivalent to the provided key, calling left with each
+// node it leaves through the right subtree (those less than the key), and right with each node it leaves through the
+// left subtree (those greater than the key), so that the last calls are made with the nearest such ancestors.
+func (t *TreeMap[K, V]) search(key K, left func(*node[K, V]), right func(*node[K, V])) (*node[K, V], error) {
+	if t.root == nil {
+		return nil, fmt.Errorf("treemap: %w", hold.ErrCollectionEmpty)
+	}
+
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		switch {
+		case c < 0:
+			if right != nil {
+				right(n)
+			}
+			n = n.left
+		case c > 0:
+			if left != nil {
+				left(n)
+			}
+			n = n.right
+		default:
+			return n, nil
+		}
+	}
+	return nil, fmt.Errorf("treemap: %w", hold.ErrNotFound)
+}
+
+func height[K comparable, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func rebalance[K comparable, V any](n *node[K, V]) *node[K, V] {
+	n.height = 1 + max(height(n.left), height(n.right))
+
+	switch b := height(n.left) - height(n.right); {
+	case b > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case b < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func rotateLeft[K comparable, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.height = 1 + max(height(n.left), height(n.right))
+	r.height = 1 + max(height(r.left), height(r.right))
+	return r
+}
+
+func rotateRight[K comparable, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.height = 1 + max(height(n.left), height(n.right))
+	l.height = 1 + max(height(l.left), height(l.right))
+	return l
+}
+
+func walk[K comparable, V any](n *node[K, V], fn func(K, V) error) error {
+	if n == nil {
+		return nil
+	}
+
+	if err := walk(n.left, fn); err != nil {
+		return err
+	}
+
+	if err := fn(n.key, n.value); err != nil {
+		return err
+	}
+	return walk(n.right, fn)
+}
@@ -0,0 +1,176 @@
+package treemap
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeMap(t *testing.T) {
+	m := New[int, string](cmp.Compare[int])
+	assert.True(t, m.IsEmpty())
+
+	_, err := m.Min()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	_, err = m.Successor(1)
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	for _, k := range []int{42, 7, 19, 3, 88, 61, 7} {
+		m.Put(k, "v"+string(rune('0'+k%10)))
+	}
+	assert.Equal(t, 6, m.Len())
+	assert.Equal(t, []int{3, 7, 19, 42, 61, 88}, m.Values())
+	assert.Equal(t, "[3:v3, 7:v7, 19:v9, 42:v2, 61:v1, 88:v8]", m.String())
+
+	var keys []int
+	iter := m.Iterate()
+	for iter.HasNext() {
+		k, err := iter.Next()
+		assert.NoError(t, err)
+		keys = append(keys, k)
+	}
+	assert.Equal(t, m.Values(), keys)
+
+	_, err = iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	minKey, err := m.Min()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, minKey)
+
+	maxKey, err := m.Max()
+	assert.NoError(t, err)
+	assert.Equal(t, 88, maxKey)
+
+	tests := []struct {
+		key         int
+		predecessor int
+		successor   int
+	}{
+		{key: 7, predecessor: 3, successor: 19},
+		{key: 19, predecessor: 7, successor: 42},
+		{key: 42, predecessor: 19, successor: 61},
+		{key: 61, predecessor: 42, successor: 88},
+	}
+
+	for _, tc := range tests {
+		p, err := m.Predecessor(tc.key)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.predecessor, p)
+
+		s, err := m.Successor(tc.key)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.successor, s)
+	}
+
+	_, err = m.Predecessor(3)
+	assert.ErrorIs(t, err, hold.ErrNotFound)
+
+	_, err = m.Successor(88)
+	assert.ErrorIs(t, err, hold.ErrNotFound)
+
+	_, err = m.Successor(20)
+	assert.ErrorIs(t, err, hold.ErrNotFound)
+
+	v, ok := m.Get(19)
+	assert.True(t, ok)
+	assert.Equal(t, "v9", v)
+
+	m.Put(19, "nineteen")
+	v, ok = m.Get(19)
+	assert.True(t, ok)
+	assert.Equal(t, "nineteen", v)
+
+	assert.NoError(t, m.Add(19, 5))
+	v, _ = m.Get(19)
+	assert.Equal(t, "nineteen", v)
+	v, ok = m.Get(5)
+	assert.True(t, ok)
+	assert.Equal(t, "", v)
+
+	_, ok = m.Get(20)
+	assert.False(t, ok)
+
+	r, err := m.Remove(42)
+	assert.NoError(t, err)
+	assert.True(t, r)
+
+	r, err = m.Remove(42)
+	assert.NoError(t, err)
+	assert.False(t, r)
+	assert.False(t, m.Contains(42))
+	assert.Equal(t, []int{3, 5, 7, 19, 61, 88}, m.Values())
+
+	errStop := errors.New("stop")
+	var visited []string
+	err = m.Entries(func(k int, v string) error {
+		visited = append(visited, v)
+		if k == 7 {
+			return errStop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, []string{"v3", "", "v7"}, visited)
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, []int{}, m.Values())
+}
+
+func TestTreeMap_Comparator(t *testing.T) {
+	m := New[string, int](func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(a), len(b)), cmp.Compare(a, b))
+	})
+	assert.NoError(t, m.AddAll(&list.List[string]{"zoro", "luffy", "nami", "usopp", "robin"}))
+	assert.Equal(t, []string{"nami", "zoro", "luffy", "robin", "usopp"}, m.Values())
+
+	s, err := m.Successor("zoro")
+	assert.NoError(t, err)
+	assert.Equal(t, "luffy", s)
+}
+
+func TestTreeMap_Balanced(t *testing.T) {
+	m := New[int, int](cmp.Compare[int])
+	keys := rand.Perm(1000)
+	for _, k := range keys {
+		m.Put(k, k*k)
+	}
+
+	for _, k := range keys[:500] {
+		r, err := m.Remove(k)
+		assert.NoError(t, err)
+		assert.True(t, r)
+	}
+
+	remaining := slices.Clone(keys[500:])
+	slices.Sort(remaining)
+	assert.Equal(t, remaining, m.Values())
+	assertBalanced(t, m.root)
+
+	for _, k := range remaining {
+		v, ok := m.Get(k)
+		assert.True(t, ok)
+		assert.Equal(t, k*k, v)
+	}
+}
+
+func assertBalanced[K comparable, V any](t *testing.T, n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	l := assertBalanced(t, n.left)
+	r := assertBalanced(t, n.right)
+	assert.LessOrEqual(t, max(l-r, r-l), 1)
+	assert.Equal(t, 1+max(l, r), n.height)
+	return n.height
+}
@@ -2,7 +2,14 @@ package trie
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/transientvariable/hold"
 )
 
 // Digitizer ...
@@ -25,16 +32,45 @@ type Digitizer interface {
 	// error will be non-nil if the Digitizer does support the character set of the provided string, or if the place is
 	// greater than Digitizer.Base().
 	FormatDigit(value string, place int) (string, error)
+
+	// Supports returns true if every character of the provided string can be digitized, along with -1. Otherwise, false
+	// is returned along with the place of the first character that cannot be digitized.
+	Supports(value string) (bool, int)
 }
 
+// defaultEndOfString is the string used by FormatDigit to represent the end of string character unless another is
+// provided using WithEndOfString.
+const defaultEndOfString = "#"
+
 type asciiDigitizer struct {
-	base int
+	base        int
+	endOfString string
+	table       map[rune]int
+}
+
+func newASCIIDigitizer(table map[rune]int, options ...func(*DigitizerOption)) *asciiDigitizer {
+	opts := &DigitizerOption{endOfString: defaultEndOfString}
+	for _, opt := range options {
+		opt(opts)
+	}
+	return &asciiDigitizer{base: len(table) + 1, endOfString: opts.endOfString, table: table}
 }
 
 // NewASCIIDigitizer creates a new Digitizer that uses the ASCII character set for digitizing strings. The base for
 // the Digitizer will be the sum of printable ASCII characters (95) plus 1 for end of string character.
-func NewASCIIDigitizer() Digitizer {
-	return &asciiDigitizer{base: len(asciiTable) + 1}
+func NewASCIIDigitizer(options ...func(*DigitizerOption)) Digitizer {
+	return newASCIIDigitizer(asciiTable, options...)
+}
+
+// NewASCIIWhitespaceDigitizer creates a new Digitizer like the one created by NewASCIIDigitizer, but that also supports
+// the tab, newline, vertical tab, form feed, and carriage return characters so that multi-line or tab-delimited values
+// can be stored. The base for the Digitizer will be the sum of printable ASCII characters (95) and the whitespace
+// characters (5) plus 1 for end of string character.
+//
+// Digits follow the order of the character codes, so the whitespace characters sort before the space character.
+// Leading and trailing whitespace is still trimmed from values added to a Trie.
+func NewASCIIWhitespaceDigitizer(options ...func(*DigitizerOption)) Digitizer {
+	return newASCIIDigitizer(asciiWhitespaceTable, options...)
 }
 
 // Base the base of the alphabet used by the ASCII Digitizer that includes the end of string character.
@@ -56,7 +92,7 @@ func (d *asciiDigitizer) NumDigitsOf(value string) int {
 
 // DigitOf returns the integer element mapped to by the digit in the given place. The returned error will be non-nil if
 // the Digitizer does support the character set of the provided string, or if the place is greater than
-// Digitizer.Base().
+// Digitizer.Base(). The returned error wraps ErrNULCharacter if the character in the given place is NUL.
 func (d *asciiDigitizer) DigitOf(value string, place int) (int, error) {
 	value = strings.TrimSpace(value)
 	if value == "" || place >= len(value) {
@@ -67,25 +103,487 @@ func (d *asciiDigitizer) DigitOf(value string, place int) (int, error) {
 		return -1, fmt.Errorf("digitizer_ascii: requested place is greater than the supported alphabet size: %d", d.Base())
 	}
 
-	i, ok := asciiTable[rune(value[place])]
+	if value[place] == 0 {
+		return -1, fmt.Errorf("digitizer_ascii: %w: node = %q, place = %d", ErrNULCharacter, value, place)
+	}
+
+	i, ok := d.table[rune(value[place])]
 	if !ok {
-		return -1, fmt.Errorf("digitizer_ascii: character for node is unsupported: node = %s, place = %d, character = %c", value, place, value[place])
+		return -1, fmt.Errorf("digitizer_ascii: %w: node = %s, place = %d, character = %c", ErrUnsupportedCharacter, value, place, value[place])
 	}
 	return i, nil
 }
 
-// FormatDigit returns a string representation of the digit in the place specified for the given node where '#' is
-// used for the end of string character.
+// FormatDigit returns a string representation of the digit in the place specified for the given node where '#', or the
+// string provided using WithEndOfString, is used for the end of string character. Whitespace characters other than the
+// space character are escaped (e.g. "\t"), as is a character that would otherwise be formatted the same as the end of
+// string character (e.g. "\#"), so that the two can always be told apart.
 func (d *asciiDigitizer) FormatDigit(value string, place int) (string, error) {
 	i, err := d.DigitOf(value, place)
 	if err != nil {
 		return "", err
 	}
 
+	if i == 0 {
+		return d.endOfString, nil
+	}
+
+	c := value[place]
+	if c < ' ' {
+		return strings.Trim(strconv.QuoteRune(rune(c)), "'"), nil
+	}
+
+	if s := string(c); s == d.endOfString {
+		return `\` + s, nil
+	}
+	return string(c), nil
+}
+
+// Supports returns true if every character of the provided string is supported by the ASCII Digitizer, along with -1.
+// Otherwise, false is returned along with the place of the first unsupported character, such as the NUL character.
+func (d *asciiDigitizer) Supports(value string) (bool, int) {
+	return supports(d, value)
+}
+
+type nonPrefixFreeASCIIDigitizer struct {
+	asciiDigitizer
+}
+
+// NewNonPrefixFreeASCIIDigitizer creates a new Digitizer that uses the ASCII character set for digitizing strings like
+// the one created by NewASCIIDigitizer, but without the end of string character. An entry in a Trie using this
+// Digitizer may therefore be a prefix of another entry, such as "car" and "card".
+func NewNonPrefixFreeASCIIDigitizer() Digitizer {
+	return &nonPrefixFreeASCIIDigitizer{*newASCIIDigitizer(asciiTable)}
+}
+
+// IsPrefixFree returns false since the Digitizer does not use an end of string character.
+func (d *nonPrefixFreeASCIIDigitizer) IsPrefixFree() bool {
+	return false
+}
+
+// NumDigitsOf returns the number of digits in the provided string.
+func (d *nonPrefixFreeASCIIDigitizer) NumDigitsOf(value string) int {
+	return len(strings.TrimSpace(value))
+}
+
+type baseNDigitizer struct {
+	base  int
+	width int
+}
+
+// NewBaseNDigitizer creates a new Digitizer that interprets each character of a string as a digit in the provided
+// base, using '0'-'9' followed by 'a'-'z' (case-insensitive) for the digit values, so the base must be between 2 and
+// 36.
+//
+// Keys are expected to be fixed-width numbers with at most width digits. Since the Digitizer does not use an end of
+// string character, a shorter key may be stored alongside the longer keys it is a prefix of.
+func NewBaseNDigitizer(base int, width int) (Digitizer, error) {
+	if base < 2 || base > 36 {
+		return nil, fmt.Errorf("digitizer_base_n: base must be between 2 and 36: %d", base)
+	}
+
+	if width <= 0 {
+		return nil, fmt.Errorf("digitizer_base_n: width must be greater than 0: %d", width)
+	}
+	return &baseNDigitizer{base: base, width: width}, nil
+}
+
+// Base returns the base of the numbers digitized by the base-N Digitizer.
+func (d *baseNDigitizer) Base() int {
+	return d.base
+}
+
+// IsPrefixFree returns false since the base-N Digitizer does not use an end of string character.
+func (d *baseNDigitizer) IsPrefixFree() bool {
+	return false
+}
+
+// NumDigitsOf returns the number of characters in the provided string.
+func (d *baseNDigitizer) NumDigitsOf(value string) int {
+	return len(strings.TrimSpace(value))
+}
+
+// DigitOf returns the value of the digit in the given place. The returned error will be non-nil if the character in the
+// given place is not a valid digit in the base of the Digitizer, or if the place is outside the configured width.
+func (d *baseNDigitizer) DigitOf(value string, place int) (int, error) {
+	value = strings.TrimSpace(value)
+	if place < 0 || place >= d.width {
+		return -1, fmt.Errorf("digitizer_base_n: place is outside the configured width: width = %d, place = %d", d.width, place)
+	}
+
+	if place >= len(value) {
+		return -1, fmt.Errorf("digitizer_base_n: place is outside the node: node = %s, place = %d", value, place)
+	}
+
+	c := value[place]
+	var i int
+	switch {
+	case c >= '0' && c <= '9':
+		i = int(c - '0')
+	case c >= 'a' && c <= 'z':
+		i = int(c-'a') + 10
+	case c >= 'A' && c <= 'Z':
+		i = int(c-'A') + 10
+	default:
+		i = d.base
+	}
+
+	if i >= d.base {
+		return -1, fmt.Errorf("digitizer_base_n: %w: not a base %d digit: node = %s, place = %d, character = %c", ErrUnsupportedCharacter, d.base, value, place, c)
+	}
+	return i, nil
+}
+
+// FormatDigit returns a string representation of the digit in the place specified for the given node.
+func (d *baseNDigitizer) FormatDigit(value string, place int) (string, error) {
+	i, err := d.DigitOf(value, place)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(i), d.base), nil
+}
+
+// Supports returns true if every character of the provided string is a digit in the base of the Digitizer and the
+// string fits the configured width, along with -1. Otherwise, false is returned along with the place of the first
+// character that is not a valid digit or is outside the configured width.
+func (d *baseNDigitizer) Supports(value string) (bool, int) {
+	return supports(d, value)
+}
+
+type unicodeDigitizer struct {
+	base  int
+	runes runeIndex
+}
+
+// NewUnicodeDigitizer creates a new Digitizer that digitizes strings by their decoded runes rather than by byte. Each
+// rune is mapped to its code point plus 1, so the base for the Digitizer will be the number of valid code points
+// (unicode.MaxRune + 1) plus 1 for end of string character.
+func NewUnicodeDigitizer() Digitizer {
+	return &unicodeDigitizer{base: unicode.MaxRune + 2}
+}
+
+// Base the base of the alphabet used by the Unicode Digitizer that includes the end of string character.
+func (d *unicodeDigitizer) Base() int {
+	return d.base
+}
+
+// IsPrefixFree returns true since the Unicode Digitizer is a prefix free.
+func (d *unicodeDigitizer) IsPrefixFree() bool {
+	return true
+}
+
+// NumDigitsOf returns the number of runes in the provided string including the end of string character.
+func (d *unicodeDigitizer) NumDigitsOf(value string) int {
+	return utf8.RuneCountInString(value) + 1
+}
+
+// DigitOf returns the integer element mapped to by the rune in the given place. The returned error will be non-nil if
+// the rune in the given place is not a valid UTF-8 encoding.
+func (d *unicodeDigitizer) DigitOf(value string, place int) (int, error) {
+	r, size := d.runes.runeAt(strings.TrimSpace(value), place)
+	if size == 0 {
+		return 0, nil
+	}
+
+	if r == utf8.RuneError && size == 1 {
+		return -1, fmt.Errorf("digitizer_unicode: %w: node = %q, place = %d", ErrUnsupportedCharacter, value, place)
+	}
+	return int(r) + 1, nil
+}
+
+// FormatDigit returns a string representation of the rune in the place specified for the given node where '#' is used
+// for the end of string character.
+func (d *unicodeDigitizer) FormatDigit(value string, place int) (string, error) {
+	i, err := d.DigitOf(value, place)
+	if err != nil {
+		return "", err
+	}
+
 	if i == 0 {
 		return "#", nil
 	}
-	return string(value[place]), nil
+	return string(rune(i - 1)), nil
+}
+
+// Supports returns true if the provided string is valid UTF-8, along with -1. Otherwise, false is returned along with
+// the place of the first rune that is not a valid UTF-8 encoding.
+func (d *unicodeDigitizer) Supports(value string) (bool, int) {
+	return supports(d, value)
+}
+
+// supports calls DigitOf for each place of the provided string, and returns false along with the first place for which
+// an error is returned, otherwise true along with -1.
+func supports(d Digitizer, value string) (bool, int) {
+	for place := range d.NumDigitsOf(value) {
+		if _, err := d.DigitOf(value, place); err != nil {
+			return false, place
+		}
+	}
+	return true, -1
+}
+
+// runeOffsets holds the byte offset of each rune of a value, followed by the length of the value.
+type runeOffsets struct {
+	value   string
+	offsets []int
+}
+
+// runeIndex locates the runes of values for the Digitizers that digitize by rune. Locating the rune in a place would
+// otherwise require decoding every rune before it, so the byte offsets of the runes of the last value located are kept,
+// which makes digitizing every place of a value, as a Trie does, take time linear in its length rather than quadratic.
+//
+// A runeIndex is safe for concurrent use: a goroutine that finds the offsets of another value decodes its own value and
+// replaces them.
+type runeIndex struct {
+	last atomic.Pointer[runeOffsets]
+}
+
+// runeAt returns the rune in the provided place of the provided value along with its width in bytes, or a width of zero
+// if the place is outside the value.
+func (x *runeIndex) runeAt(value string, place int) (rune, int) {
+	if place < 0 || value == "" {
+		return 0, 0
+	}
+
+	offsets := x.offsetsOf(value)
+	if place >= len(offsets)-1 {
+		return 0, 0
+	}
+
+	start, end := offsets[place], offsets[place+1]
+	r, _ := utf8.DecodeRuneInString(value[start:end])
+	return r, end - start
+}
+
+// offsetsOf returns the byte offsets of the runes of the provided value, which are only decoded if they are not already
+// held for the same string. Strings are immutable, so a string with the same data and length holds the same runes.
+func (x *runeIndex) offsetsOf(value string) []int {
+	if o := x.last.Load(); o != nil && len(o.value) == len(value) && unsafe.StringData(o.value) == unsafe.StringData(value) {
+		return o.offsets
+	}
+
+	// Ranging over a string decodes it like utf8.DecodeRuneInString, so an invalid byte is a rune of width 1.
+	offsets := make([]int, 0, len(value)+1)
+	for i := range value {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(value))
+
+	x.last.Store(&runeOffsets{value: value, offsets: offsets})
+	return offsets
+}
+
+// ByteDigitizer is a Digitizer that digitizes the raw bytes of a value, so that binary keys, including those with
+// embedded NUL bytes, can be stored in a Trie. Whitespace is significant to a ByteDigitizer: a Trie using one does not
+// trim leading and trailing whitespace from the values it is provided.
+type ByteDigitizer interface {
+	Digitizer
+
+	// NumDigitsOfBytes returns the number of digits in the provided value.
+	NumDigitsOfBytes(value []byte) int
+
+	// DigitOfBytes returns the element of digit place for the provided value, like DigitOf, without converting the
+	// value to a string.
+	DigitOfBytes(value []byte, place int) (int, error)
+}
+
+type byteDigitizer struct {
+	endOfString string
+}
+
+// NewByteDigitizer creates a new ByteDigitizer that digitizes values byte by byte, mapping each byte to its value plus
+// 1. The base for the Digitizer will be the number of byte values (256) plus 1 for end of string character.
+func NewByteDigitizer(options ...func(*DigitizerOption)) ByteDigitizer {
+	opts := &DigitizerOption{endOfString: defaultEndOfString}
+	for _, opt := range options {
+		opt(opts)
+	}
+	return &byteDigitizer{endOfString: opts.endOfString}
+}
+
+// Base the base of the alphabet used by the byte Digitizer that includes the end of string character.
+func (d *byteDigitizer) Base() int {
+	return 1<<8 + 1
+}
+
+// IsPrefixFree returns true since the byte Digitizer is a prefix free.
+func (d *byteDigitizer) IsPrefixFree() bool {
+	return true
+}
+
+// NumDigitsOf returns the number of bytes in the provided string including the end of string character.
+func (d *byteDigitizer) NumDigitsOf(value string) int {
+	return len(value) + 1
+}
+
+// NumDigitsOfBytes returns the number of bytes in the provided value including the end of string character.
+func (d *byteDigitizer) NumDigitsOfBytes(value []byte) int {
+	return len(value) + 1
+}
+
+// DigitOf returns the integer element mapped to by the byte in the given place. The returned error will be non-nil if
+// the place is negative.
+func (d *byteDigitizer) DigitOf(value string, place int) (int, error) {
+	if place < 0 {
+		return -1, fmt.Errorf("digitizer_byte: place must not be negative: %d", place)
+	}
+
+	if place >= len(value) {
+		return 0, nil
+	}
+	return int(value[place]) + 1, nil
+}
+
+// DigitOfBytes returns the integer element mapped to by the byte in the given place. The returned error will be non-nil
+// if the place is negative.
+func (d *byteDigitizer) DigitOfBytes(value []byte, place int) (int, error) {
+	if place < 0 {
+		return -1, fmt.Errorf("digitizer_byte: place must not be negative: %d", place)
+	}
+
+	if place >= len(value) {
+		return 0, nil
+	}
+	return int(value[place]) + 1, nil
+}
+
+// FormatDigit returns a string representation of the byte in the place specified for the given node where '#', or the
+// string provided using WithEndOfString, is used for the end of string character. Bytes other than the printable ASCII
+// characters are formatted as hexadecimal escapes (e.g. "\x00"), and a character that would otherwise be formatted the
+// same as the end of string character is escaped (e.g. "\#").
+func (d *byteDigitizer) FormatDigit(value string, place int) (string, error) {
+	i, err := d.DigitOf(value, place)
+	if err != nil {
+		return "", err
+	}
+
+	if i == 0 {
+		return d.endOfString, nil
+	}
+
+	c := value[place]
+	if c < ' ' || c > '~' {
+		return fmt.Sprintf(`\x%02x`, c), nil
+	}
+
+	if s := string(c); s == d.endOfString {
+		return `\` + s, nil
+	}
+	return string(c), nil
+}
+
+// Supports returns true along with -1, since every byte is supported by the byte Digitizer.
+func (d *byteDigitizer) Supports(string) (bool, int) {
+	return true, -1
+}
+
+type alphabetDigitizer struct {
+	alphabet   []rune
+	digits     map[rune]int
+	prefixFree bool
+	runes      runeIndex
+}
+
+// NewAlphabetDigitizer creates a new Digitizer for strings made up of the characters of the provided alphabet, in
+// which each character is mapped to a digit by its position, so that entries in a Trie using the Digitizer are ordered
+// by the order of the characters in the alphabet rather than by their character codes. For example, a Digitizer for DNA
+// sequences can be created using NewAlphabetDigitizer("ACGT", true).
+//
+// If prefixFree is true, the digit 0 is reserved for the end of string character, so the base for the Digitizer will
+// be the number of characters in the alphabet plus 1. Otherwise, the base will be the number of characters in the
+// alphabet, and the Digitizer does not use an end of string character, like the one created by
+// NewNonPrefixFreeASCIIDigitizer.
+//
+// The returned error will be non-nil if the alphabet is empty, is not valid UTF-8, or contains a character more than
+// once.
+func NewAlphabetDigitizer(alphabet string, prefixFree bool) (Digitizer, error) {
+	if alphabet == "" {
+		return nil, fmt.Errorf("digitizer_alphabet: %w", hold.ErrValueRequired)
+	}
+
+	if !utf8.ValidString(alphabet) {
+		return nil, fmt.Errorf("digitizer_alphabet: alphabet is not valid UTF-8: %q", alphabet)
+	}
+
+	d := &alphabetDigitizer{alphabet: []rune(alphabet), digits: make(map[rune]int), prefixFree: prefixFree}
+	for i, r := range d.alphabet {
+		if _, ok := d.digits[r]; ok {
+			return nil, fmt.Errorf("digitizer_alphabet: alphabet contains duplicate character: alphabet = %q, character = %q", alphabet, r)
+		}
+
+		if prefixFree {
+			i++
+		}
+		d.digits[r] = i
+	}
+	return d, nil
+}
+
+// Base returns the number of characters in the alphabet, plus 1 for the end of string character if the Digitizer is
+// prefix free.
+func (d *alphabetDigitizer) Base() int {
+	if d.prefixFree {
+		return len(d.alphabet) + 1
+	}
+	return len(d.alphabet)
+}
+
+// IsPrefixFree returns whether the Digitizer was created with an end of string character.
+func (d *alphabetDigitizer) IsPrefixFree() bool {
+	return d.prefixFree
+}
+
+// NumDigitsOf returns the number of characters in the provided string, including the end of string character if the
+// Digitizer is prefix free.
+func (d *alphabetDigitizer) NumDigitsOf(value string) int {
+	n := utf8.RuneCountInString(strings.TrimSpace(value))
+	if d.prefixFree {
+		n++
+	}
+	return n
+}
+
+// DigitOf returns the position in the alphabet of the character in the given place, offset by 1 if the Digitizer is
+// prefix free. The returned error will be non-nil if the character in the given place is not in the alphabet, or if the
+// Digitizer is not prefix free and the place is outside the provided string.
+func (d *alphabetDigitizer) DigitOf(value string, place int) (int, error) {
+	value = strings.TrimSpace(value)
+	r, size := d.runes.runeAt(value, place)
+	if size == 0 {
+		if d.prefixFree {
+			return 0, nil
+		}
+		return -1, fmt.Errorf("digitizer_alphabet: place is outside the node: node = %q, place = %d", value, place)
+	}
+
+	i, ok := d.digits[r]
+	if !ok {
+		return -1, fmt.Errorf("digitizer_alphabet: %w: node = %q, place = %d, character = %q", ErrUnsupportedCharacter, value, place, r)
+	}
+	return i, nil
+}
+
+// FormatDigit returns a string representation of the character in the place specified for the given node where '#' is
+// used for the end of string character.
+func (d *alphabetDigitizer) FormatDigit(value string, place int) (string, error) {
+	i, err := d.DigitOf(value, place)
+	if err != nil {
+		return "", err
+	}
+
+	if d.prefixFree {
+		if i == 0 {
+			return defaultEndOfString, nil
+		}
+		i--
+	}
+	return string(d.alphabet[i]), nil
+}
+
+// Supports returns true if every character of the provided string is in the alphabet of the Digitizer, along with -1.
+// Otherwise, false is returned along with the place of the first character that is not in the alphabet.
+func (d *alphabetDigitizer) Supports(value string) (bool, int) {
+	return supports(d, value)
 }
 
 var asciiTable = map[rune]int{
@@ -185,3 +683,21 @@ var asciiTable = map[rune]int{
 	'}':  94,
 	'~':  95,
 }
+
+// asciiWhitespace holds the whitespace characters supported by NewASCIIWhitespaceDigitizer in addition to the printable
+// ASCII characters, in the order of their character codes.
+const asciiWhitespace = "\t\n\v\f\r"
+
+// asciiWhitespaceTable extends asciiTable with the characters in asciiWhitespace, which are mapped to the digits
+// before those of the printable characters.
+var asciiWhitespaceTable = func() map[rune]int {
+	table := make(map[rune]int, len(asciiWhitespace)+len(asciiTable))
+	for i, r := range asciiWhitespace {
+		table[r] = i + 1
+	}
+
+	for r, i := range asciiTable {
+		table[r] = i + len(asciiWhitespace)
+	}
+	return table
+}()
@@ -0,0 +1,667 @@
+package trie
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestASCIIDigitizer_EndOfString(t *testing.T) {
+	format := func(d Digitizer, value string) []string {
+		var digits []string
+		for place := range d.NumDigitsOf(value) {
+			f, err := d.FormatDigit(value, place)
+			assert.NoError(t, err)
+			digits = append(digits, f)
+		}
+		return digits
+	}
+
+	d := NewASCIIDigitizer()
+	assert.Equal(t, []string{"c", `\#`, "#"}, format(d, "c#"))
+	assert.Equal(t, []string{"#"}, format(d, ""))
+
+	d = NewASCIIDigitizer(WithEndOfString("$"))
+	assert.Equal(t, []string{"c", "#", "$"}, format(d, "c#"))
+	assert.Equal(t, []string{"u", "s", `\$`, "$"}, format(d, "us$"))
+
+	d = NewASCIIDigitizer(WithEndOfString("<EOS>"))
+	assert.Equal(t, []string{"#", "1", "<EOS>"}, format(d, "#1"))
+
+	d = NewASCIIDigitizer(WithEndOfString(" "))
+	assert.Equal(t, []string{"a", "#"}, format(d, "a"))
+
+	d = NewASCIIWhitespaceDigitizer(WithEndOfString("$"))
+	assert.Equal(t, []string{"a", `\t`, "#", "$"}, format(d, "a\t#"))
+
+	trie, err := New(WithDigitizer(NewASCIIDigitizer(WithEndOfString("<EOS>"))))
+	assert.NoError(t, err)
+	assert.NoError(t, trie.Add("c#", "c", "#c", "c##"))
+	assertContentEquals(t, trie, "[#c, c, c#, c##]")
+
+	for _, v := range trie.Values() {
+		digits := format(NewASCIIDigitizer(WithEndOfString("<EOS>")), v)
+		assert.Equal(t, "<EOS>", digits[len(digits)-1])
+		assert.Equal(t, v, strings.Join(digits[:len(digits)-1], ""))
+	}
+}
+
+func TestASCIIDigitizer_NUL(t *testing.T) {
+	for name, d := range map[string]Digitizer{
+		"ASCII":              NewASCIIDigitizer(),
+		"ASCIIWhitespace":    NewASCIIWhitespaceDigitizer(),
+		"NonPrefixFreeASCII": NewNonPrefixFreeASCIIDigitizer(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := d.DigitOf("a\x00b", 1)
+			assert.ErrorIs(t, err, ErrNULCharacter)
+
+			_, err = d.FormatDigit("a\x00b", 1)
+			assert.ErrorIs(t, err, ErrNULCharacter)
+
+			_, err = d.DigitOf("a\x01b", 1)
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, ErrNULCharacter)
+
+			trie, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+			assert.NoError(t, trie.Add("ab"))
+			assert.ErrorIs(t, trie.Add("a\x00b"), ErrNULCharacter)
+			assert.ErrorIs(t, trie.Add("\x00"), ErrNULCharacter)
+			assert.Equal(t, []string{"ab"}, trie.Values())
+			assertContains(t, trie, "a\x00b", false)
+		})
+	}
+}
+
+func TestASCIIWhitespaceDigitizer(t *testing.T) {
+	d := NewASCIIWhitespaceDigitizer()
+	assert.True(t, d.IsPrefixFree())
+	assert.Equal(t, 101, d.Base())
+
+	tests := []struct {
+		value  string
+		digit  int
+		format string
+	}{
+		{value: "a\tb", digit: 1, format: `\t`},
+		{value: "a\nb", digit: 2, format: `\n`},
+		{value: "a\rb", digit: 5, format: `\r`},
+		{value: "a b", digit: 6, format: " "},
+		{value: "a~b", digit: 100, format: "~"},
+	}
+
+	for _, tc := range tests {
+		digit, err := d.DigitOf(tc.value, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.digit, digit)
+
+		f, err := d.FormatDigit(tc.value, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.format, f)
+	}
+
+	digit, err := d.DigitOf("a", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, digit)
+
+	_, err = d.DigitOf("a\x00b", 1)
+	assert.Error(t, err)
+
+	_, err = NewASCIIDigitizer().DigitOf("a\tb", 1)
+	assert.Error(t, err)
+}
+
+func TestTrie_ASCIIWhitespaceDigitizer(t *testing.T) {
+	trie, err := New(WithDigitizer(NewASCIIWhitespaceDigitizer()))
+	assert.NoError(t, err)
+
+	values := []string{"name\tage", "name age", "line one\nline two", "line one", "a\r\nb", "name\t"}
+	l := list.List[string](values)
+	assert.NoError(t, trie.AddAll(&l))
+	assertSize(t, trie, 6)
+	assert.Equal(t, []string{"a\r\nb", "line one", "line one\nline two", "name", "name\tage", "name age"}, trie.Values())
+
+	for _, v := range []string{"name\tage", "line one\nline two", "a\r\nb", "name"} {
+		e, err := trie.Entry(v)
+		assert.NoError(t, err)
+		assert.Equal(t, v, e.Value())
+	}
+	assertContains(t, trie, "name\nage", false)
+
+	l = list.List[string]{}
+	assert.NoError(t, trie.Completions("line one\nl", &l))
+	assertContentEquals(t, &l, "[line one\nline two]")
+}
+
+func TestBaseNDigitizer(t *testing.T) {
+	d, err := NewBaseNDigitizer(16, 4)
+	assert.NoError(t, err)
+	assert.False(t, d.IsPrefixFree())
+	assert.Equal(t, 16, d.Base())
+	assert.Equal(t, 4, d.NumDigitsOf("0aF9"))
+
+	for place, expected := range []int{0, 10, 15, 9} {
+		digit, err := d.DigitOf("0aF9", place)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, digit)
+	}
+
+	f, err := d.FormatDigit("0aF9", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "f", f)
+
+	_, err = d.DigitOf("0ag9", 2)
+	assert.Error(t, err)
+
+	_, err = d.DigitOf("0af91", 4)
+	assert.Error(t, err)
+
+	for _, base := range []int{1, 37} {
+		_, err = NewBaseNDigitizer(base, 4)
+		assert.Error(t, err)
+	}
+
+	_, err = NewBaseNDigitizer(2, 0)
+	assert.Error(t, err)
+}
+
+func TestTrie_BaseNDigitizer(t *testing.T) {
+	d, err := NewBaseNDigitizer(2, 4)
+	assert.NoError(t, err)
+
+	trie, err := New(WithDigitizer(d))
+	assert.NoError(t, err)
+
+	err = trie.AddAll(&list.List[string]{"1011", "0001", "1000", "0110", "1111", "0000", "1010"})
+	assert.NoError(t, err)
+	assertSize(t, trie, 7)
+	assertContentEquals(t, trie, "[0000, 0001, 0110, 1000, 1010, 1011, 1111]")
+	assertContains(t, trie, "1010", true)
+	assertContains(t, trie, "1001", false)
+	assertContains(t, trie, "101", false)
+
+	assert.Error(t, trie.Add("0120"))
+	assert.Error(t, trie.Add("10110"))
+	assert.Error(t, trie.Add("1011"))
+	assertSize(t, trie, 7)
+
+	tests := []struct {
+		prefix   string
+		expected string
+	}{
+		{prefix: "1", expected: "[1000, 1010, 1011, 1111]"},
+		{prefix: "10", expected: "[1000, 1010, 1011]"},
+		{prefix: "101", expected: "[1010, 1011]"},
+		{prefix: "0", expected: "[0000, 0001, 0110]"},
+		{prefix: "01", expected: "[0110]"},
+		{prefix: "1011", expected: "[1011]"},
+		{prefix: "1001", expected: "[]"},
+		{prefix: "11", expected: "[1111]"},
+	}
+
+	for _, tc := range tests {
+		l := list.List[string]{}
+		err := trie.Completions(tc.prefix, &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, tc.expected)
+	}
+
+	minValue, err := trie.Min()
+	assert.NoError(t, err)
+	assert.Equal(t, "0000", minValue)
+
+	maxValue, err := trie.Max()
+	assert.NoError(t, err)
+	assert.Equal(t, "1111", maxValue)
+
+	s, err := trie.Successor("0110")
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", s)
+
+	_, err = trie.Remove("1010")
+	assert.NoError(t, err)
+	assertContentEquals(t, trie, "[0000, 0001, 0110, 1000, 1011, 1111]")
+
+	assert.NoError(t, trie.Add("101"))
+	assertContains(t, trie, "101", true)
+	assertContentEquals(t, trie, "[0000, 0001, 0110, 1000, 101, 1011, 1111]")
+}
+
+func TestUnicodeDigitizer(t *testing.T) {
+	d := NewUnicodeDigitizer()
+	assert.True(t, d.IsPrefixFree())
+
+	value := "café"
+	assert.Equal(t, 5, d.NumDigitsOf(value))
+
+	digit, err := d.DigitOf(value, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int('é')+1, digit)
+
+	digit, err = d.DigitOf(value, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, digit)
+
+	f, err := d.FormatDigit(value, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "é", f)
+
+	f, err = d.FormatDigit(value, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "#", f)
+
+	_, err = d.DigitOf("a\xffb", 1)
+	assert.Error(t, err)
+
+	// Values digitized in an interleaved order are each located correctly.
+	long := strings.Repeat("aé日🙂", 1000)
+	runes := []rune(long)
+	for place, r := range runes {
+		digit, err := d.DigitOf(long, place)
+		assert.NoError(t, err)
+		assert.Equal(t, int(r)+1, digit)
+
+		digit, err = d.DigitOf(value, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, int('é')+1, digit)
+	}
+
+	digit, err = d.DigitOf(long, len(runes))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, digit)
+}
+
+func BenchmarkUnicodeDigitizer(b *testing.B) {
+	d := NewUnicodeDigitizer()
+	for _, n := range []int{16, 256, 4096} {
+		value := strings.Repeat("é", n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for place := range d.NumDigitsOf(value) {
+					if _, err := d.DigitOf(value, place); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTrie_UnicodeDigitizer(t *testing.T) {
+	trie, err := New(WithDigitizer(NewUnicodeDigitizer()))
+	assert.NoError(t, err)
+
+	err = trie.AddAll(&list.List[string]{"naïve", "café", "cafe", "cafe\u0301", "car", "日本", "日本語", "🙂", "😀"})
+	assert.NoError(t, err)
+	assert.Equal(t, 9, trie.Len())
+	assertContentEquals(t, trie, "[cafe, cafe\u0301, café, car, naïve, 日本, 日本語, 😀, 🙂]")
+	assertContains(t, trie, "café", true)
+	assertContains(t, trie, "😀", true)
+	assertContains(t, trie, "caf", false)
+	assertContains(t, trie, "😁", false)
+
+	t.Run("Completions", func(t *testing.T) {
+		l := list.List[string]{}
+		err := trie.Completions("caf", &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, "[cafe, cafe\u0301, café]")
+
+		l.Clear()
+		err = trie.Completions("日本", &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, "[日本, 日本語]")
+
+		l.Clear()
+		err = trie.Completions("😀", &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, "[😀]")
+	})
+
+	t.Run("LongestCommonPrefix", func(t *testing.T) {
+		l := list.List[string]{}
+		err := trie.LongestCommonPrefix("cafés", &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, "[café]")
+
+		l.Clear()
+		err = trie.LongestCommonPrefix("日本人", &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, "[日本, 日本語]")
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		r, err := trie.Remove("cafe\u0301")
+		assert.NoError(t, err)
+		assert.True(t, r)
+		assertContains(t, trie, "cafe\u0301", false)
+		assertContains(t, trie, "cafe", true)
+		assertContentEquals(t, trie, "[cafe, café, car, naïve, 日本, 日本語, 😀, 🙂]")
+
+		p, err := trie.Predecessor("日本")
+		assert.NoError(t, err)
+		assertNodeValue(t, p, "naïve")
+	})
+}
+
+func TestByteDigitizer(t *testing.T) {
+	d := NewByteDigitizer()
+	assert.True(t, d.IsPrefixFree())
+	assert.Equal(t, 257, d.Base())
+
+	value := "a\x00\xff#"
+	assert.Equal(t, 5, d.NumDigitsOf(value))
+	assert.Equal(t, 5, d.NumDigitsOfBytes([]byte(value)))
+
+	for place, expected := range []int{'a' + 1, 1, 256, '#' + 1, 0} {
+		digit, err := d.DigitOf(value, place)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, digit)
+
+		digit, err = d.DigitOfBytes([]byte(value), place)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, digit)
+	}
+
+	for place, expected := range []string{"a", `\x00`, `\xff`, `\#`, "#"} {
+		f, err := d.FormatDigit(value, place)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, f)
+	}
+
+	_, err := d.DigitOf(value, -1)
+	assert.Error(t, err)
+
+	_, err = d.DigitOfBytes([]byte(value), -1)
+	assert.Error(t, err)
+}
+
+func TestTrie_ByteDigitizer(t *testing.T) {
+	trie, err := New(WithDigitizer(NewByteDigitizer()))
+	assert.NoError(t, err)
+
+	keys := [][]byte{{'a', 0, 'b'}, {0xff, 0}, {'a'}, {0}, {' ', 'a', ' '}, {'a', 0}}
+	assert.NoError(t, trie.AddBytes(keys...))
+	assert.Equal(t, 6, trie.Len())
+	assert.Equal(t, []string{"\x00", " a ", "a", "a\x00", "a\x00b", "\xff\x00"}, trie.Values())
+
+	for _, k := range keys {
+		assert.True(t, trie.ContainsBytes(k))
+	}
+	assert.False(t, trie.ContainsBytes([]byte{'a', 0, 0}))
+	assert.False(t, trie.ContainsBytes([]byte("a ")))
+	assert.Error(t, trie.AddBytes([]byte{'a', 0}))
+
+	l := list.List[string]{}
+	assert.NoError(t, trie.Completions("a\x00", &l))
+	assert.Equal(t, []string{"a\x00", "a\x00b"}, l.Values())
+
+	removed, err := trie.Remove("a\x00")
+	assert.NoError(t, err)
+	assert.True(t, removed)
+	assert.False(t, trie.ContainsBytes([]byte{'a', 0}))
+	assert.True(t, trie.ContainsBytes([]byte{'a', 0, 'b'}))
+	assert.False(t, trie.ContainsBytes(nil))
+
+	key := []byte{0xff, 0}
+	allocs := testing.AllocsPerRun(100, func() {
+		if !trie.ContainsBytes(key) {
+			t.Fatal("expected key to be found")
+		}
+	})
+	assert.Zero(t, allocs)
+
+	ascii, err := New()
+	assert.NoError(t, err)
+	assert.Error(t, ascii.AddBytes([]byte{'a', 0, 'b'}))
+	assert.True(t, ascii.IsEmpty())
+}
+
+func TestAlphabetDigitizer(t *testing.T) {
+	d, err := NewAlphabetDigitizer("TGCA", true)
+	assert.NoError(t, err)
+	assert.True(t, d.IsPrefixFree())
+	assert.Equal(t, 5, d.Base())
+	assert.Equal(t, 4, d.NumDigitsOf("GAT"))
+
+	for place, expected := range []int{2, 4, 1, 0} {
+		digit, err := d.DigitOf("GAT", place)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, digit)
+	}
+
+	f, err := d.FormatDigit("GAT", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "A", f)
+
+	f, err = d.FormatDigit("GAT", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "#", f)
+
+	_, err = d.DigitOf("GAU", 2)
+	assert.Error(t, err)
+
+	d, err = NewAlphabetDigitizer("TGCA", false)
+	assert.NoError(t, err)
+	assert.False(t, d.IsPrefixFree())
+	assert.Equal(t, 4, d.Base())
+	assert.Equal(t, 3, d.NumDigitsOf("GAT"))
+
+	digit, err := d.DigitOf("GAT", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, digit)
+
+	_, err = d.DigitOf("GAT", 3)
+	assert.Error(t, err)
+
+	d, err = NewAlphabetDigitizer("αβγ", true)
+	assert.NoError(t, err)
+
+	digit, err = d.DigitOf("βγ", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, digit)
+
+	for _, alphabet := range []string{"", "ACGA", "AC\xff"} {
+		_, err := NewAlphabetDigitizer(alphabet, true)
+		assert.Error(t, err, alphabet)
+	}
+}
+
+func TestTrie_AlphabetDigitizer(t *testing.T) {
+	for _, prefixFree := range []bool{true, false} {
+		t.Run(fmt.Sprintf("PrefixFree=%t", prefixFree), func(t *testing.T) {
+			d, err := NewAlphabetDigitizer("TGCA", prefixFree)
+			assert.NoError(t, err)
+
+			trie, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+			assert.NoError(t, trie.AddAll(&list.List[string]{"ACGT", "GATTACA", "TT", "CAT", "A", "AC", "TA"}))
+			assertContentEquals(t, trie, "[TT, TA, GATTACA, CAT, A, AC, ACGT]")
+			assertContains(t, trie, "AC", true)
+			assertContains(t, trie, "ACG", false)
+			assert.Error(t, trie.Add("ACGU"))
+
+			l := list.List[string]{}
+			assert.NoError(t, trie.Completions("A", &l))
+			assertContentEquals(t, &l, "[A, AC, ACGT]")
+
+			maxValue, err := trie.Max()
+			assert.NoError(t, err)
+			assert.Equal(t, "ACGT", maxValue)
+		})
+	}
+}
+
+func TestDigitizer_Supports(t *testing.T) {
+	baseN, err := NewBaseNDigitizer(2, 4)
+	assert.NoError(t, err)
+
+	dna, err := NewAlphabetDigitizer("ACGT", true)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		digitizer Digitizer
+		value     string
+		supported bool
+		place     int
+		err       error
+	}{
+		{name: "ASCII", digitizer: NewASCIIDigitizer(), value: "luffy", supported: true, place: -1},
+		{name: "ASCIIUnsupported", digitizer: NewASCIIDigitizer(), value: "lüffy", supported: false, place: 1, err: ErrUnsupportedCharacter},
+		{name: "ASCIINUL", digitizer: NewASCIIDigitizer(), value: "zo\x00ro", supported: false, place: 2, err: ErrNULCharacter},
+		{name: "ASCIIWhitespace", digitizer: NewASCIIWhitespaceDigitizer(), value: "a\tb", supported: true, place: -1},
+		{name: "NonPrefixFreeASCII", digitizer: NewNonPrefixFreeASCIIDigitizer(), value: "na\x01mi", supported: false, place: 2, err: ErrUnsupportedCharacter},
+		{name: "BaseN", digitizer: baseN, value: "1010", supported: true, place: -1},
+		{name: "BaseNInvalidDigit", digitizer: baseN, value: "1020", supported: false, place: 2, err: ErrUnsupportedCharacter},
+		{name: "BaseNTooWide", digitizer: baseN, value: "10101", supported: false, place: 4},
+		{name: "Unicode", digitizer: NewUnicodeDigitizer(), value: "日本語", supported: true, place: -1},
+		{name: "UnicodeInvalid", digitizer: NewUnicodeDigitizer(), value: "日\xff語", supported: false, place: 1, err: ErrUnsupportedCharacter},
+		{name: "Byte", digitizer: NewByteDigitizer(), value: "\x00\xff", supported: true, place: -1},
+		{name: "Alphabet", digitizer: dna, value: "GATTACA", supported: true, place: -1},
+		{name: "AlphabetUnsupported", digitizer: dna, value: "GAUTACA", supported: false, place: 2, err: ErrUnsupportedCharacter},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			supported, place := tc.digitizer.Supports(tc.value)
+			assert.Equal(t, tc.supported, supported)
+			assert.Equal(t, tc.place, place)
+
+			// The error for the unsupported character can be matched using errors.Is.
+			if tc.err != nil {
+				_, err := tc.digitizer.DigitOf(tc.value, tc.place)
+				assert.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestTrie_UnsupportedCharacter(t *testing.T) {
+	dna, err := NewAlphabetDigitizer("ACGT", true)
+	assert.NoError(t, err)
+
+	trie, err := New(WithDigitizer(dna))
+	assert.NoError(t, err)
+	assert.NoError(t, trie.Add("GATTACA", "GATT"))
+
+	stats := trie.Stats()
+	err = trie.Add("GATTAUA")
+	assert.ErrorIs(t, err, ErrUnsupportedCharacter)
+	assert.Contains(t, err.Error(), "place = 5")
+
+	assert.ErrorIs(t, trie.AddEntry(NewEntry("GGU", nil)), ErrUnsupportedCharacter)
+	assert.ErrorIs(t, trie.Put("UG", nil), ErrUnsupportedCharacter)
+	assert.Equal(t, stats, trie.Stats())
+	assert.Equal(t, []string{"GATT", "GATTACA"}, trie.Values())
+
+	ascii, err := New()
+	assert.NoError(t, err)
+
+	err = ascii.Add("zo\x00ro")
+	assert.ErrorIs(t, err, ErrUnsupportedCharacter)
+	assert.ErrorIs(t, err, ErrNULCharacter)
+	assert.Nil(t, rootOf(ascii))
+}
+
+func TestTrie_NonPrefixFreeASCIIDigitizer(t *testing.T) {
+	d := NewNonPrefixFreeASCIIDigitizer()
+	assert.False(t, d.IsPrefixFree())
+	assert.Equal(t, 4, d.NumDigitsOf("card"))
+
+	newTries := map[string]func() (Trie, error){
+		"Ascending": func() (Trie, error) {
+			trie, err := New(WithDigitizer(d))
+			if err != nil {
+				return nil, err
+			}
+			return trie, trie.AddAll(&list.List[string]{"car", "card", "cards", "cat", "ca"})
+		},
+		"Descending": func() (Trie, error) {
+			trie, err := New(WithDigitizer(d))
+			if err != nil {
+				return nil, err
+			}
+			return trie, trie.AddAll(&list.List[string]{"cat", "cards", "card", "car", "ca"})
+		},
+		"Sorted": func() (Trie, error) {
+			var entries []Entry
+			for _, v := range []string{"ca", "car", "card", "cards", "cat"} {
+				entries = append(entries, NewEntry(v, nil))
+			}
+			return NewFromSorted(entries, WithDigitizer(d))
+		},
+	}
+
+	for name, newTrie := range newTries {
+		t.Run(name, func(t *testing.T) {
+			trie, err := newTrie()
+			assert.NoError(t, err)
+			assertSize(t, trie, 5)
+			assertContentEquals(t, trie, "[ca, car, card, cards, cat]")
+
+			for _, value := range []string{"ca", "car", "card", "cards", "cat"} {
+				assertContains(t, trie, value, true)
+			}
+			assertContains(t, trie, "c", false)
+			assertContains(t, trie, "cards!", false)
+			assert.Error(t, trie.Add("card"))
+
+			tests := []struct {
+				prefix   string
+				expected string
+			}{
+				{prefix: "c", expected: "[ca, car, card, cards, cat]"},
+				{prefix: "car", expected: "[car, card, cards]"},
+				{prefix: "card", expected: "[card, cards]"},
+				{prefix: "cards", expected: "[cards]"},
+				{prefix: "cardsx", expected: "[]"},
+			}
+
+			for _, tc := range tests {
+				l := list.List[string]{}
+				err := trie.Completions(tc.prefix, &l)
+				assert.NoError(t, err)
+				assertContentEquals(t, &l, tc.expected)
+			}
+
+			lcp := list.List[string]{}
+			assert.NoError(t, trie.LongestCommonPrefix("cart", &lcp))
+			assertContentEquals(t, &lcp, "[car, card, cards]")
+
+			lcp = list.List[string]{}
+			assert.NoError(t, trie.LongestCommonPrefix("cards", &lcp))
+			assertContentEquals(t, &lcp, "[cards]")
+
+			p, err := trie.Predecessor("card")
+			assert.NoError(t, err)
+			assert.Equal(t, "car", p)
+
+			p, err = trie.Predecessor("cat")
+			assert.NoError(t, err)
+			assert.Equal(t, "cards", p)
+
+			s, err := trie.Successor("car")
+			assert.NoError(t, err)
+			assert.Equal(t, "card", s)
+
+			s, err = trie.Successor("cards")
+			assert.NoError(t, err)
+			assert.Equal(t, "cat", s)
+
+			e, ok, err := trie.LongestPrefixMatch("cardigan")
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, "card", e.Value())
+
+			_, err = trie.Remove("cards")
+			assert.NoError(t, err)
+			assertContains(t, trie, "card", true)
+			assertContentEquals(t, trie, "[ca, car, card, cat]")
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// snapshotVersion is the version of the format produced by Trie.MarshalBinary.
+const snapshotVersion = 1
+
+type snapshot struct {
+	Version int
+	Base    int
+	Entries []snapshotEntry
+}
+
+type snapshotEntry struct {
+	Value string
+	Data  any
+}
+
+// jsonEntry is the JSON encoding of an Entry produced by Trie.MarshalJSON.
+type jsonEntry struct {
+	Value string `json:"value"`
+	Data  any    `json:"data"`
+}
+
+// Load creates a new Trie with the provided options, and populates it with the entries from data produced by
+// Trie.MarshalBinary.
+//
+// The returned error will be non-nil if data cannot be decoded, or if the base of the Digitizer used to produce data
+// does not match the base of the Digitizer configured for the new Trie.
+func Load(data []byte, options ...func(*Option)) (Trie, error) {
+	t, err := New(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.(*trie).GobDecode(data); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// GobDecode replaces the entries in the Trie with the entries from data produced by Trie.GobEncode or
+// Trie.MarshalBinary, so that a Trie created using New can be passed to gob.Decoder.Decode.
+//
+// Data for each entry is decoded using encoding/gob, so concrete types other than the Go basic types must be registered
+// with gob.Register by the caller. The returned error will be non-nil if data cannot be decoded, if the base of the
+// Digitizer used to produce data does not match the base of the Digitizer of the Trie, or if the decoded entries cannot
+// be inserted, in which case the Trie is not modified.
+func (t *trie) GobDecode(data []byte) error {
+	var s snapshot
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&s); err != nil {
+		return fmt.Errorf("trie: could not decode entries: %w", err)
+	}
+
+	if s.Version != snapshotVersion {
+		return fmt.Errorf("trie: unsupported encoding version: %d", s.Version)
+	}
+
+	if base := t.digitizer.Base(); s.Base != base {
+		return fmt.Errorf("trie: digitizer base mismatch: encoded = %d, configured = %d", s.Base, base)
+	}
+
+	entries := make([]Entry, len(s.Entries))
+	for i, e := range s.Entries {
+		entries[i] = NewEntry(e.Value, e.Data)
+	}
+
+	r := t.emptyCopy()
+	if err := r.addSorted(entries); err != nil {
+		return err
+	}
+	*t = *r
+	return nil
+}
+
+// GobEncode encodes the entries in the Trie, along with their data, in the same form as Trie.MarshalBinary, so that
+// the Trie can be passed to gob.Encoder.Encode, such as when it is transferred using net/rpc.
+//
+// Data for each entry is encoded using encoding/gob, so concrete types other than the Go basic types must be
+// registered with gob.Register by the caller. The returned error will be non-nil if the data for any entry cannot be
+// encoded.
+func (t *trie) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// MarshalBinary encodes the entries in the Trie, along with their data, into a binary form that can be restored using
+// Load.
+//
+// Data for each entry is encoded using encoding/gob, so concrete types other than the Go basic types must be
+// registered with gob.Register. The returned error will be non-nil if the data for any entry cannot be encoded.
+func (t *trie) MarshalBinary() ([]byte, error) {
+	s := snapshot{
+		Version: snapshotVersion,
+		Base:    t.digitizer.Base(),
+		Entries: make([]snapshotEntry, 0, t.Len()),
+	}
+
+	iter := newIterator(t, t.head)
+	for iter.advance() {
+		e, err := iter.get()
+		if err != nil {
+			return nil, err
+		}
+		s.Entries = append(s.Entries, snapshotEntry{Value: e.Value(), Data: e.Data()})
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		return nil, fmt.Errorf("trie: could not encode entries: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSON returns the JSON encoding of the Trie as an array of objects in iteration order, each holding the value
+// of an Entry as "value" and its data as "data", so that the order of the entries is preserved.
+//
+// Entries are encoded as they are visited, without building an intermediate collection of the entries. The returned
+// error will be non-nil if the data for any entry cannot be encoded.
+func (t *trie) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+
+	iter := newIterator(t, t.head)
+	for iter.advance() {
+		e, err := iter.get()
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := json.Marshal(jsonEntry{Value: e.Value(), Data: e.Data()})
+		if err != nil {
+			return nil, fmt.Errorf("trie: could not encode entry %s: %w", e.Value(), err)
+		}
+
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(b)
+	}
+
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON replaces the entries in the Trie with the entries decoded from the JSON array produced by MarshalJSON.
+//
+// Data is decoded into the types used by encoding/json for an interface value, such as float64 for numbers and
+// map[string]any for objects. The entries need not be in iteration order. The entries are inserted into a new Trie that
+// replaces the entries in the Trie once all of them have been inserted. The returned error will be non-nil if data
+// cannot be decoded, or if any of the decoded entries cannot be inserted, in which case the Trie is not modified.
+func (t *trie) UnmarshalJSON(data []byte) error {
+	var entries []jsonEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("trie: could not decode entries: %w", err)
+	}
+
+	r := t.emptyCopy()
+	for _, e := range entries {
+		if err := r.AddEntry(NewEntry(e.Value, e.Data)); err != nil {
+			return err
+		}
+	}
+	*t = *r
+	return nil
+}
+
+// ExportDOT writes the structure of the Trie to the provided writer as a Graphviz DOT graph. Edges are labeled with the
+// digit they represent as formatted by the Digitizer, and leaves are labeled with the value of their Entry.
+//
+// Nodes are named in the order they are visited, starting with n0 for the root. The returned error will be non-nil if
+// writing to the provided writer fails.
+func (t *trie) ExportDOT(w io.Writer) error {
+	e := &dotExporter{digitizer: t.digitizer, w: w}
+	e.printf("digraph trie {\n")
+	e.printf("  node [shape=circle, label=\"\"];\n")
+	if t.root != nil {
+		e.printf("  n0 [shape=point];\n")
+		e.exportChildren(t.root, "n0", 0)
+	}
+	e.printf("}\n")
+
+	if e.err != nil {
+		return fmt.Errorf("trie: could not export structure: %w", e.err)
+	}
+	return nil
+}
+
+// dotExporter writes the nodes and edges of a Trie in the DOT language, retaining the first error encountered so that
+// the structure can be walked without checking every write.
+type dotExporter struct {
+	digitizer Digitizer
+	err       error
+	numNodes  int
+	w         io.Writer
+}
+
+func (e *dotExporter) exportChildren(n Node, name string, place int) {
+	for i := n.NextChildIndex(0); i != childNotFound && e.err == nil; i = n.NextChildIndex(i + 1) {
+		child, err := n.ChildAt(i)
+		if err != nil {
+			e.err = err
+			return
+		}
+
+		e.numNodes++
+		childName := fmt.Sprintf("n%d", e.numNodes)
+		if child.IsTerminal() {
+			e.printf("  %s [shape=doublecircle, label=\"%s\"];\n", childName, dotEscape(child.Value().Value()))
+		}
+
+		digit, err := e.digitizer.FormatDigit(terminalValue(child), place)
+		if err != nil {
+			e.err = err
+			return
+		}
+		e.printf("  %s -> %s [label=\"%s\"];\n", name, childName, dotEscape(digit))
+		e.exportChildren(child, childName, place+1)
+	}
+}
+
+func (e *dotExporter) printf(format string, args ...any) {
+	if e.err == nil {
+		_, e.err = fmt.Fprintf(e.w, format, args...)
+	}
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func dotEscape(s string) string {
+	return dotEscaper.Replace(s)
+}
+
+// terminalValue returns the value of the first Entry in the subtree rooted at the provided node, which shares the digits
+// on the path to the node.
+func terminalValue(n Node) string {
+	for !n.IsTerminal() {
+		c, _ := n.ChildAt(n.NextChildIndex(0))
+		n = c
+	}
+	return n.Value().Value()
+}
+
+// WriteTo writes the value of each Entry in the Trie to the provided writer in iteration order, one value per line,
+// and returns the number of bytes written. Each value is written as a double-quoted Go string literal, as produced by
+// strconv.Quote, since the values of a Trie using the ASCII whitespace or byte Digitizer can contain line breaks.
+//
+// Entries are written as they are visited rather than being collected first, so the Trie can be written regardless of
+// its size. Each value results in a call to Write, so a writer such as a file should be wrapped in a bufio.Writer. The
+// returned error will be non-nil if writing to the provided writer fails.
+func (t *trie) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	iter := newIterator(t, t.head)
+	for iter.advance() {
+		e, err := iter.get()
+		if err != nil {
+			return written, err
+		}
+
+		n, err := io.WriteString(w, strconv.Quote(e.Value())+"\n")
+		written += int64(n)
+		if err != nil {
+			return written, fmt.Errorf("trie: could not write entries: %w", err)
+		}
+	}
+	return written, nil
+}
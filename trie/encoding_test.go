@@ -0,0 +1,383 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type payload struct {
+	Name  string
+	Count int
+}
+
+func TestTrie_MarshalBinary(t *testing.T) {
+	gob.Register(payload{})
+
+	trie, err := New()
+	assert.NoError(t, err)
+
+	for _, e := range []Entry{
+		NewEntry("dog", "bark"),
+		NewEntry("dab", 42),
+		NewEntry("dabble", payload{Name: "dabble", Count: 3}),
+		NewEntry("cat", nil),
+		NewEntry("caterpillar", []string{"leaf", "butterfly"}),
+	} {
+		assert.NoError(t, trie.AddEntry(e))
+	}
+
+	data, err := trie.MarshalBinary()
+	assert.NoError(t, err)
+
+	loaded, err := Load(data)
+	assert.NoError(t, err)
+	assert.Equal(t, trie.Len(), loaded.Len())
+	assertContentEquals(t, loaded, "[cat, caterpillar, dab, dabble, dog]")
+
+	expected, err := trie.Entries()
+	assert.NoError(t, err)
+
+	actual, err := loaded.Entries()
+	assert.NoError(t, err)
+	assert.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].Value(), actual[i].Value())
+		assert.Equal(t, expected[i].Data(), actual[i].Data())
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		trie, err := New()
+		assert.NoError(t, err)
+
+		data, err := trie.MarshalBinary()
+		assert.NoError(t, err)
+
+		loaded, err := Load(data)
+		assert.NoError(t, err)
+		assert.True(t, loaded.IsEmpty())
+	})
+
+	t.Run("BaseMismatch", func(t *testing.T) {
+		_, err := Load(data, WithDigitizer(NewUnicodeDigitizer()))
+		assert.ErrorContains(t, err, "digitizer base mismatch")
+	})
+
+	t.Run("Corrupt", func(t *testing.T) {
+		_, err := Load(data[:len(data)/2])
+		assert.Error(t, err)
+	})
+
+	t.Run("Unserializable", func(t *testing.T) {
+		trie, err := New()
+		assert.NoError(t, err)
+		assert.NoError(t, trie.AddEntry(NewEntry("fn", func() {})))
+
+		_, err = trie.MarshalBinary()
+		assert.ErrorContains(t, err, "could not encode entries")
+	})
+}
+
+func TestTrie_Gob(t *testing.T) {
+	gob.Register(payload{})
+
+	trie, err := New()
+	assert.NoError(t, err)
+
+	for _, e := range []Entry{
+		NewEntry("dog", "bark"),
+		NewEntry("dab", 42),
+		NewEntry("dabble", payload{Name: "dabble", Count: 3}),
+		NewEntry("cat", nil),
+	} {
+		assert.NoError(t, trie.AddEntry(e))
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(trie))
+
+	decoded, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, decoded.Add("zebra"))
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	assertContentEquals(t, decoded, "[cat, dab, dabble, dog]")
+	assert.NoError(t, decoded.Validate())
+
+	for _, v := range []string{"cat", "dab", "dabble", "dog"} {
+		expected, _ := trie.Get(v)
+		actual, ok := decoded.Get(v)
+		assert.True(t, ok)
+		assert.Equal(t, expected, actual)
+	}
+
+	t.Run("Struct", func(t *testing.T) {
+		type message struct {
+			Names *SyncTrie
+			Tags  list.List[string]
+		}
+
+		names, err := New()
+		assert.NoError(t, err)
+		assert.NoError(t, names.Add("luffy", "zoro", "nami"))
+
+		var buf bytes.Buffer
+		err = gob.NewEncoder(&buf).Encode(message{Names: Synchronized(names), Tags: list.List[string]{"crew"}})
+		assert.NoError(t, err)
+
+		empty, err := New()
+		assert.NoError(t, err)
+
+		m := message{Names: Synchronized(empty)}
+		assert.NoError(t, gob.NewDecoder(&buf).Decode(&m))
+		assertContentEquals(t, m.Names, "[luffy, nami, zoro]")
+		assert.Equal(t, list.List[string]{"crew"}, m.Tags)
+	})
+
+	t.Run("BaseMismatch", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, gob.NewEncoder(&buf).Encode(trie))
+
+		decoded, err := New(WithDigitizer(NewUnicodeDigitizer()))
+		assert.NoError(t, err)
+		assert.NoError(t, decoded.Add("zebra"))
+
+		err = gob.NewDecoder(&buf).Decode(decoded)
+		assert.ErrorContains(t, err, "digitizer base mismatch")
+		assertContentEquals(t, decoded, "[zebra]")
+	})
+
+	t.Run("Capacity", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, gob.NewEncoder(&buf).Encode(trie))
+
+		decoded, err := New(WithMaxEntries(2))
+		assert.NoError(t, err)
+		assert.NoError(t, decoded.Add("zebra"))
+
+		err = gob.NewDecoder(&buf).Decode(decoded)
+		assert.ErrorIs(t, err, hold.ErrCapacityReached)
+		assertContentEquals(t, decoded, "[zebra]")
+		assert.NoError(t, decoded.Validate())
+	})
+}
+
+func TestTrie_JSON(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	for _, e := range []Entry{
+		NewEntry("dog", "bark"),
+		NewEntry("dab", 42.5),
+		NewEntry("dabble", map[string]any{"name": "dabble", "count": 3.0, "tags": []any{"a", "b"}}),
+		NewEntry("cat", nil),
+		NewEntry("caterpillar", []any{"leaf", true}),
+	} {
+		assert.NoError(t, trie.AddEntry(e))
+	}
+
+	data, err := json.Marshal(trie)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"value": "cat", "data": null},
+		{"value": "caterpillar", "data": ["leaf", true]},
+		{"value": "dab", "data": 42.5},
+		{"value": "dabble", "data": {"name": "dabble", "count": 3, "tags": ["a", "b"]}},
+		{"value": "dog", "data": "bark"}
+	]`, string(data))
+
+	decoded, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, decoded.Add("zebra"))
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assertContentEquals(t, decoded, "[cat, caterpillar, dab, dabble, dog]")
+
+	expected, err := trie.Entries()
+	assert.NoError(t, err)
+
+	actual, err := decoded.Entries()
+	assert.NoError(t, err)
+	assert.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].Value(), actual[i].Value())
+		assert.Equal(t, expected[i].Data(), actual[i].Data())
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		empty, err := New()
+		assert.NoError(t, err)
+
+		data, err := json.Marshal(Synchronized(empty))
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", string(data))
+
+		assert.NoError(t, json.Unmarshal(data, decoded))
+		assert.True(t, decoded.IsEmpty())
+	})
+
+	t.Run("Unordered", func(t *testing.T) {
+		s := Synchronized(nil)
+		assert.NoError(t, json.Unmarshal([]byte(`[{"value": "zoro", "data": 1}, {"value": "luffy"}]`), s))
+		assertContentEquals(t, s, "[luffy, zoro]")
+
+		data, ok := s.Get("zoro")
+		assert.True(t, ok)
+		assert.Equal(t, 1.0, data)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		decoded, err := New()
+		assert.NoError(t, err)
+		assert.NoError(t, decoded.Add("zebra"))
+
+		assert.Error(t, json.Unmarshal([]byte(`{"value": "cat"}`), decoded))
+		assertContentEquals(t, decoded, "[zebra]")
+
+		// An entry that cannot be inserted must leave the Trie unchanged, even after other entries were inserted.
+		err = json.Unmarshal([]byte(`[{"value": "cat"}, {"value": "dog"}, {"value": "cat"}]`), decoded)
+		assert.ErrorContains(t, err, "prefix-free requirement")
+		assertContentEquals(t, decoded, "[zebra]")
+		assert.NoError(t, decoded.Validate())
+
+		capped, err := New(WithMaxEntries(2))
+		assert.NoError(t, err)
+		assert.NoError(t, capped.Add("zebra"))
+
+		err = json.Unmarshal([]byte(`[{"value": "cat"}, {"value": "dog"}, {"value": "emu"}]`), capped)
+		assert.ErrorIs(t, err, hold.ErrCapacityReached)
+		assertContentEquals(t, capped, "[zebra]")
+	})
+
+	t.Run("Unserializable", func(t *testing.T) {
+		trie, err := New()
+		assert.NoError(t, err)
+		assert.NoError(t, trie.AddEntry(NewEntry("fn", func() {})))
+
+		_, err = json.Marshal(trie)
+		assert.ErrorContains(t, err, "could not encode entry fn")
+	})
+}
+
+func TestTrie_WriteTo(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, trie.AddAll(&list.List[string]{"dog", "dab", "dabble", "cat", "caterpillar"}))
+
+	var buf bytes.Buffer
+	n, err := trie.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+
+	entries, err := trie.Entries()
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, len(entries))
+	for i, e := range entries {
+		v, err := strconv.Unquote(lines[i])
+		assert.NoError(t, err)
+		assert.Equal(t, e.Value(), v)
+	}
+
+	t.Run("LineBreaks", func(t *testing.T) {
+		trie, err := New(WithDigitizer(NewByteDigitizer()))
+		assert.NoError(t, err)
+		assert.NoError(t, trie.Add("line\nbreak", "tab\t", "quote\"", "\x00"))
+
+		var buf bytes.Buffer
+		_, err = trie.WriteTo(&buf)
+		assert.NoError(t, err)
+
+		var values []string
+		for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+			v, err := strconv.Unquote(line)
+			assert.NoError(t, err)
+			values = append(values, v)
+		}
+		assert.Equal(t, trie.Values(), values)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		trie, err := New()
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		n, err := trie.WriteTo(&buf)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("WriteError", func(t *testing.T) {
+		errWrite := errors.New("disk full")
+		n, err := trie.WriteTo(&failingWriter{limit: 8, err: errWrite})
+		assert.ErrorIs(t, err, errWrite)
+		assert.Equal(t, int64(8), n)
+	})
+}
+
+func TestTrie_ExportDOT(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, trie.AddAll(&list.List[string]{"ab", "ac", "b\"c"}))
+
+	var buf bytes.Buffer
+	assert.NoError(t, trie.ExportDOT(&buf))
+
+	expected := `digraph trie {
+  node [shape=circle, label=""];
+  n0 [shape=point];
+  n0 -> n1 [label="a"];
+  n1 -> n2 [label="b"];
+  n3 [shape=doublecircle, label="ab"];
+  n2 -> n3 [label="#"];
+  n1 -> n4 [label="c"];
+  n5 [shape=doublecircle, label="ac"];
+  n4 -> n5 [label="#"];
+  n0 -> n6 [label="b"];
+  n6 -> n7 [label="\""];
+  n7 -> n8 [label="c"];
+  n9 [shape=doublecircle, label="b\"c"];
+  n8 -> n9 [label="#"];
+}
+`
+	assert.Equal(t, expected, buf.String())
+
+	t.Run("Empty", func(t *testing.T) {
+		trie, err := New()
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		assert.NoError(t, trie.ExportDOT(&buf))
+		assert.Equal(t, "digraph trie {\n  node [shape=circle, label=\"\"];\n}\n", buf.String())
+	})
+
+	t.Run("WriteError", func(t *testing.T) {
+		errWrite := errors.New("disk full")
+		assert.ErrorIs(t, trie.ExportDOT(&failingWriter{limit: 32, err: errWrite}), errWrite)
+	})
+}
+
+// failingWriter accepts up to limit bytes, then fails every write with err.
+type failingWriter struct {
+	limit int
+	err   error
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+		return n, w.err
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
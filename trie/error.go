@@ -0,0 +1,21 @@
+package trie
+
+const (
+	// ErrCorrupted is returned by Trie.Validate when the linked list of the leaves of a Trie is inconsistent.
+	ErrCorrupted = trieError("leaf list is corrupted")
+
+	// ErrNULCharacter is returned by the ASCII Digitizers for a value containing the NUL character, which is not
+	// supported since the digit it would be mapped to, 0, is reserved for the end of string character. Use a
+	// ByteDigitizer to store values containing the NUL character.
+	ErrNULCharacter = trieError("NUL character is unsupported, digit 0 is reserved for the end of string")
+
+	// ErrUnsupportedCharacter is returned by a Digitizer for a character it cannot digitize, and by a Trie for a value
+	// containing such a character, before the Trie is modified.
+	ErrUnsupportedCharacter = trieError("character is unsupported by the digitizer")
+)
+
+type trieError string
+
+func (e trieError) Error() string {
+	return string(e)
+}
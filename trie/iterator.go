@@ -6,9 +6,18 @@ import (
 	"github.com/transientvariable/hold"
 )
 
+var (
+	_ hold.BidirectionalIterator[string] = (*iterator)(nil)
+	_ hold.MutableIterator[string]       = (*iterator)(nil)
+	_ hold.PeekableIterator[string]      = (*iterator)(nil)
+	_ hold.ResettableIterator[string]    = (*iterator)(nil)
+	_ hold.MutableIterator[string]       = (*prefixIterator)(nil)
+)
+
 type iterator struct {
 	trie    *trie
 	pointer Leaf
+	last    Leaf
 }
 
 func newIterator(trie *trie, pointer Leaf) *iterator {
@@ -20,8 +29,36 @@ func (i *iterator) HasNext() bool {
 	return i.hasNext()
 }
 
-// Next ...
+// HasPrevious ...
+func (i *iterator) HasPrevious() bool {
+	return i.hasPrevious()
+}
+
+// Next returns the entry after the cursor and moves the cursor past it, so a following call to Previous returns the
+// same entry.
 func (i *iterator) Next() (string, error) {
+	if !i.hasNext() {
+		return "", fmt.Errorf("trie_iter: %w", hold.ErrNoMoreElements)
+	}
+
+	i.advance()
+	entry, err := i.get()
+	if err != nil {
+		return "", err
+	}
+	i.last = i.pointer
+	return entry.Value(), nil
+}
+
+// Peek returns the entry after the cursor without moving the cursor, so a following call to Next returns the same entry.
+func (i *iterator) Peek() (string, error) {
+	if !i.hasNext() {
+		return "", fmt.Errorf("trie_iter: %w", hold.ErrNoMoreElements)
+	}
+
+	pointer := i.pointer
+	defer func() { i.pointer = pointer }()
+
 	i.advance()
 	entry, err := i.get()
 	if err != nil {
@@ -30,6 +67,40 @@ func (i *iterator) Next() (string, error) {
 	return entry.Value(), nil
 }
 
+// Previous returns the entry before the cursor and moves the cursor in front of it, so a following call to Next
+// returns the same entry.
+func (i *iterator) Previous() (string, error) {
+	if !i.hasPrevious() {
+		return "", fmt.Errorf("trie_iter: %w", hold.ErrNoMoreElements)
+	}
+
+	entry, err := i.get()
+	if err != nil {
+		return "", err
+	}
+	i.last = i.pointer
+	i.retreat()
+	return entry.Value(), nil
+}
+
+// Remove removes the entry returned by the last call to Next or Previous from the Trie. The position of the cursor is
+// preserved, so iteration continues with the entry that followed (or preceded) the removed entry.
+func (i *iterator) Remove() error {
+	if i.last == nil || i.last.IsDeleted() {
+		return fmt.Errorf("trie_iter: %w", hold.ErrNotFound)
+	}
+
+	last := i.last
+	i.last = nil
+	return i.trie.remove(last)
+}
+
+// Reset moves the cursor back to the head of the Trie, so a following call to Next returns the first entry.
+func (i *iterator) Reset() {
+	i.pointer = i.trie.head
+	i.last = nil
+}
+
 func (i *iterator) advance() bool {
 	if i.pointer.IsTail() {
 		return false
@@ -51,12 +122,15 @@ func (i *iterator) get() (Entry, error) {
 }
 
 func (i *iterator) hasNext() bool {
-	if i.pointer.IsDeleted() {
-		i.skipRemovedElements(i.pointer)
-	}
+	i.settle()
 	return !i.pointer.IsTail() && !i.pointer.Next().IsTail()
 }
 
+func (i *iterator) hasPrevious() bool {
+	i.settle()
+	return !i.pointer.IsHead() && !i.pointer.IsTail()
+}
+
 func (i *iterator) inCollection() bool {
 	if i.pointer.IsHead() || i.pointer.IsTail() {
 		return false
@@ -64,15 +138,6 @@ func (i *iterator) inCollection() bool {
 	return !i.pointer.IsDeleted()
 }
 
-func (i *iterator) remove() error {
-	if i.inCollection() {
-		if err := i.trie.remove(i.pointer); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 func (i *iterator) retreat() bool {
 	if !i.pointer.IsTail() && !i.pointer.IsHead() && i.pointer.IsDeleted() {
 		i.pointer = i.skipRemovedElements(i.pointer)
@@ -82,6 +147,14 @@ func (i *iterator) retreat() bool {
 	return !i.pointer.IsHead()
 }
 
+// settle moves a pointer to a leaf that has since been removed from the Trie back onto the closest entry before it
+// that is still in the Trie, so the position of the cursor is preserved.
+func (i *iterator) settle() {
+	if !i.pointer.IsHead() && !i.pointer.IsTail() && i.pointer.IsDeleted() {
+		i.pointer = i.skipRemovedElements(i.pointer).Previous()
+	}
+}
+
 func (i *iterator) skipRemovedElements(leafNode Leaf) Leaf {
 	if leafNode.IsHead() || leafNode.IsTail() || !leafNode.IsDeleted() {
 		return leafNode
@@ -90,3 +163,42 @@ func (i *iterator) skipRemovedElements(leafNode Leaf) Leaf {
 	leafNode.SetNext(i.skipRemovedElements(leafNode.Next()))
 	return leafNode.Next()
 }
+
+// prefixIterator is a hold.Iterator that visits the entries of an underlying iterator for as long as their digits start
+// with a given sequence of digits.
+type prefixIterator struct {
+	iterator *iterator
+	prefix   []int
+}
+
+func newPrefixIterator(iterator *iterator, prefix []int) *prefixIterator {
+	return &prefixIterator{iterator: iterator, prefix: prefix}
+}
+
+// HasNext ...
+func (i *prefixIterator) HasNext() bool {
+	v, err := i.iterator.Peek()
+	if err != nil {
+		return false
+	}
+
+	for place, d := range i.prefix {
+		if digit, err := i.iterator.trie.digitizer.DigitOf(v, place); err != nil || digit != d {
+			return false
+		}
+	}
+	return true
+}
+
+// Next returns the next entry that matches the prefix.
+func (i *prefixIterator) Next() (string, error) {
+	if !i.HasNext() {
+		return "", fmt.Errorf("trie_iter: %w", hold.ErrNoMoreElements)
+	}
+	return i.iterator.Next()
+}
+
+// Remove removes the entry returned by the last call to Next from the Trie.
+func (i *prefixIterator) Remove() error {
+	return i.iterator.Remove()
+}
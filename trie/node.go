@@ -2,6 +2,7 @@ package trie
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 )
@@ -10,54 +11,117 @@ import (
 type Node interface {
 	AddChild(index int, child Node) error
 	ChildAt(index int) (Node, error)
+
+	// Children returns the child storage of the node. For a node that preallocates a child slot for every digit, the
+	// slice is indexed by digit and holds nil for the digits without a child. For a node that only stores the children
+	// that are present, the slice holds those children ordered by digit, so ChildAt and NextChildIndex should be used to
+	// find the child for a digit.
 	Children() []Node
 	HasChildren() bool
 	IsLeaf() bool
 	IsRoot() bool
+	IsTerminal() bool
+	NextChildIndex(from int) int
 	Parent() Node
+	PreviousChildIndex(from int) int
 	SetParent(parent Node)
 	SetValue(entry Entry)
 	RemoveChildAt(index int) bool
 	Value() Entry
 }
 
-type node struct {
-	children    []Node
-	isRoot      bool
-	numChildren int
-	parent      Node
-	value       Entry
-}
+// maxDenseCapacity is the largest capacity for which a node preallocates a child slot for every digit. Nodes with a
+// larger capacity (e.g. those created for a Unicode Digitizer), and nodes with the compactLayout, only store the
+// children that are present, ordered by index.
+const maxDenseCapacity = 1 << 10
+
+// maxSparseChildren is the number of children a node with a capacity of at most maxDenseCapacity stores compactly
+// before it is promoted to preallocate a child slot for every digit. Since most nodes of a large Trie have a single
+// child, this avoids allocating a full set of child slots for each of them, while nodes with many children keep
+// constant time access to a child.
+const maxSparseChildren = 16
+
+// nodeLayout determines how a node stores its children.
+type nodeLayout int
+
+const (
+	// hybridLayout stores the children that are present, ordered by index, until a node has more than
+	// maxSparseChildren children, and then promotes the node to the denseLayout.
+	hybridLayout nodeLayout = iota
+
+	// compactLayout only stores the children that are present, ordered by index.
+	compactLayout
+
+	// denseLayout preallocates a child slot for every digit.
+	denseLayout
+)
 
-func newNode(capacity int) Node {
+type node struct {
+	capacity     int
+	children     []Node
+	count        int
+	indices      []int
+	isRoot       bool
+	isSparse     bool
+	isPromotable bool
+	isTerminal   bool
+	numChildren  int
+	parent       Node
+	value        Entry
+}
+
+// newNode creates a node that can hold children for up to the provided number of digits, which are stored according
+// to the provided layout. Nodes with a capacity larger than maxDenseCapacity always use the compactLayout.
+func newNode(capacity int, layout nodeLayout) Node {
 	if capacity <= 0 {
 		return &node{}
 	}
-	return &node{children: make([]Node, capacity)}
-}
 
-func newRootNode(capacity int) Node {
-	return &node{
-		children: make([]Node, capacity),
-		isRoot:   true,
+	if layout == compactLayout || capacity > maxDenseCapacity {
+		return &node{capacity: capacity, isSparse: true}
 	}
+
+	if layout == denseLayout {
+		return &node{capacity: capacity, children: make([]Node, capacity)}
+	}
+	return &node{capacity: capacity, isSparse: true, isPromotable: true}
+}
+
+func newRootNode(capacity int, layout nodeLayout) Node {
+	n := newNode(capacity, layout).(*node)
+	n.isRoot = true
+	return n
 }
 
 // AddChild ...
 func (n *node) AddChild(index int, child Node) error {
-	if index < 0 || index >= len(n.children) {
-		return errors.Errorf("trie: index out of bounds for node: capacity = %d, requested index = %d", cap(n.children), index)
-	}
-
-	if n.children[index] != nil {
-		return errors.Errorf("child exists at index %v", index)
+	if index < 0 || index >= n.capacity {
+		return errors.Errorf("trie: index out of bounds for node: capacity = %d, requested index = %d", n.capacity, index)
 	}
 
-	if n.children[index] == nil {
-		n.numChildren++
+	if n.isSparse {
+		i, found := n.search(index)
+		if found {
+			return errors.Errorf("child exists at index %v", index)
+		}
+		n.indices = append(n.indices, 0)
+		copy(n.indices[i+1:], n.indices[i:])
+		n.indices[i] = index
+		n.children = append(n.children, nil)
+		copy(n.children[i+1:], n.children[i:])
+		n.children[i] = child
+
+		if n.isPromotable && len(n.children) > maxSparseChildren {
+			n.promote()
+		}
+	} else {
+		if n.children[index] != nil {
+			return errors.Errorf("child exists at index %v", index)
+		}
+		n.children[index] = child
 	}
 
-	n.children[index] = child
+	n.numChildren++
 	child.SetParent(n)
 	return nil
 }
@@ -67,10 +131,17 @@ func (n *node) ChildAt(index int) (Node, error) {
 	if err := n.checkBounds(index); err != nil {
 		return nil, err
 	}
+
+	if n.isSparse {
+		if i, found := n.search(index); found {
+			return n.children[i], nil
+		}
+		return nil, nil
+	}
 	return n.children[index], nil
 }
 
-// Children ...
+// Children returns the child storage of the node, which is indexed by digit only if the node is not sparse.
 func (n *node) Children() []Node {
 	return n.children
 }
@@ -90,17 +161,80 @@ func (n *node) IsRoot() bool {
 	return n.isRoot
 }
 
+// IsTerminal returns whether an Entry ends at the node, in which case the node holds the Entry as its value.
+func (n *node) IsTerminal() bool {
+	return n.isTerminal
+}
+
+// NextChildIndex returns the lowest index greater than or equal to from that holds a child, or -1 if there is none.
+func (n *node) NextChildIndex(from int) int {
+	if from < 0 {
+		from = 0
+	}
+
+	if n.isSparse {
+		if i, _ := n.search(from); i < len(n.indices) {
+			return n.indices[i]
+		}
+		return childNotFound
+	}
+
+	for i := from; i < len(n.children); i++ {
+		if n.children[i] != nil {
+			return i
+		}
+	}
+	return childNotFound
+}
+
 // Parent ...
 func (n *node) Parent() Node {
 	return n.parent
 }
 
+// PreviousChildIndex returns the highest index less than or equal to from that holds a child, or -1 if there is none.
+func (n *node) PreviousChildIndex(from int) int {
+	if from >= n.capacity {
+		from = n.capacity - 1
+	}
+
+	if n.isSparse {
+		i, found := n.search(from)
+		if !found {
+			i--
+		}
+
+		if i >= 0 {
+			return n.indices[i]
+		}
+		return childNotFound
+	}
+
+	for i := from; i >= 0; i-- {
+		if n.children[i] != nil {
+			return i
+		}
+	}
+	return childNotFound
+}
+
 // RemoveChildAt ...
 func (n *node) RemoveChildAt(index int) bool {
 	if err := n.checkBounds(index); err != nil {
 		return false
 	}
 
+	if n.isSparse {
+		i, found := n.search(index)
+		if !found {
+			return false
+		}
+		n.indices = append(n.indices[:i], n.indices[i+1:]...)
+		n.children = append(n.children[:i], n.children[i+1:]...)
+		n.numChildren--
+		return true
+	}
+
 	if n.children[index] != nil {
 		n.children[index] = nil
 		n.numChildren--
@@ -130,12 +264,38 @@ func (n *node) String() string {
 }
 
 func (n *node) checkBounds(index int) error {
-	if index < 0 || index > len(n.children) {
-		return errors.Errorf("index out of bounds [Node.capacity = %v, requested index = %v]", cap(n.children), index)
+	if index < 0 || index >= n.capacity {
+		return errors.Errorf("index out of bounds [Node.capacity = %v, requested index = %v]", n.capacity, index)
 	}
 	return nil
 }
 
+// promote replaces the compact child storage of a sparse node with a child slot for every digit.
+func (n *node) promote() {
+	children := make([]Node, n.capacity)
+	for i, index := range n.indices {
+		children[index] = n.children[i]
+	}
+	n.children = children
+	n.indices = nil
+	n.isPromotable = false
+	n.isSparse = false
+}
+
+// compact reallocates the child storage of a sparse node to fit its children, releasing the capacity left behind by
+// removed children.
+func (n *node) compact() {
+	if !n.isSparse || cap(n.children) == len(n.children) {
+		return
+	}
+	n.children = append([]Node(nil), n.children...)
+	n.indices = append([]int(nil), n.indices...)
+}
+
+func (n *node) search(index int) (int, bool) {
+	return slices.BinarySearch(n.indices, index)
+}
+
 // Leaf ...
 type Leaf interface {
 	Node
@@ -159,9 +319,13 @@ type leaf struct {
 	isTail   bool
 }
 
-// AddChild delegates the call to Node.AddChild for the Leaf.
+// AddChild delegates the call to Node.AddChild for the Leaf, and sets the Leaf as the parent of the child.
 func (l *leaf) AddChild(index int, child Node) error {
-	return l.node.AddChild(index, child)
+	if err := l.node.AddChild(index, child); err != nil {
+		return err
+	}
+	child.SetParent(l)
+	return nil
 }
 
 // ChildAt delegates the call to Node.ChildAt for the Leaf.
@@ -184,11 +348,26 @@ func (l *leaf) IsRoot() bool {
 	return l.node.IsRoot()
 }
 
+// IsTerminal delegates the call to Node.IsTerminal for the Leaf.
+func (l *leaf) IsTerminal() bool {
+	return l.node.IsTerminal()
+}
+
+// NextChildIndex delegates the call to Node.NextChildIndex for the Leaf.
+func (l *leaf) NextChildIndex(from int) int {
+	return l.node.NextChildIndex(from)
+}
+
 // Parent delegates the call to Node.Parent for the Leaf.
 func (l *leaf) Parent() Node {
 	return l.node.Parent()
 }
 
+// PreviousChildIndex delegates the call to Node.PreviousChildIndex for the Leaf.
+func (l *leaf) PreviousChildIndex(from int) int {
+	return l.node.PreviousChildIndex(from)
+}
+
 // SetParent delegates the call to Node.SetParent for the Leaf.
 func (l *leaf) SetParent(parent Node) {
 	l.node.SetParent(parent)
@@ -209,8 +388,50 @@ func (l *leaf) Value() Entry {
 	return l.node.Value()
 }
 
-func newLeaf() Leaf {
-	return &leaf{node: newNode(0)}
+// compactNode compacts the provided node, or the node wrapped by the provided Leaf.
+func compactNode(n Node) {
+	switch c := n.(type) {
+	case *node:
+		c.compact()
+	case *leaf:
+		compactNode(c.node)
+	}
+}
+
+// subtreeCount returns a pointer to the number of entries in the subtree of the provided node, or the node wrapped by
+// the provided Leaf, which is only maintained for a Trie created using WithSubtreeCounts.
+func subtreeCount(n Node) *int {
+	switch c := n.(type) {
+	case *node:
+		return &c.count
+	case *leaf:
+		return subtreeCount(c.node)
+	}
+	return nil
+}
+
+// newLeaf creates a Leaf that can hold children for up to the provided number of digits, which are stored according to
+// the provided layout as described by newNode. A capacity of zero creates a Leaf that cannot have children, which is
+// sufficient for a prefix-free Digitizer.
+func newLeaf(capacity int, layout nodeLayout) Leaf {
+	return wrapLeaf(newNode(capacity, layout))
+}
+
+// wrapLeaf creates a Leaf that wraps the provided node, marking the node as terminal.
+func wrapLeaf(n Node) Leaf {
+	if n, ok := n.(*node); ok {
+		n.isTerminal = true
+	}
+	return &leaf{node: n}
+}
+
+// unwrap returns the node wrapped by the Leaf after clearing its terminal marking and Entry.
+func (l *leaf) unwrap() Node {
+	if n, ok := l.node.(*node); ok {
+		n.isTerminal = false
+		n.value = nil
+	}
+	return l.node
 }
 
 // AddAfter ...
@@ -1,8 +1,36 @@
 package trie
 
+import "strings"
+
 // Option is a container for optional properties that can be used to initialize a Trie.
 type Option struct {
-	digitizer Digitizer
+	digitizer     Digitizer
+	maxEntries    int
+	nodeLayout    nodeLayout
+	subtreeCounts bool
+}
+
+// WithCompactNodes sets the Option for how the nodes of the Trie store their children. If enabled is true, the nodes
+// always store their children in a compact slice, ordered by digit, that only holds the children that are present. If
+// enabled is false, every node preallocates a child slot for every digit of the Digitizer. Iteration order is
+// unaffected.
+//
+// When the Option is not provided, a node stores its children in a compact slice until it has more than a small number
+// of children, and is then promoted to preallocate a child slot for every digit, which keeps most nodes of a large Trie
+// small while nodes with many children keep constant time access to a child.
+//
+// Compact nodes make visiting the children of a node proportional to the number of children rather than the base of
+// the Digitizer (e.g. 96 for the ASCII Digitizer), which speeds up traversals such as iteration and Completions, and
+// reduces the memory held by nodes with many children. In exchange, locating the child for a digit takes a binary
+// search rather than an index, and adding a child shifts the children that follow it. Nodes for a Digitizer with a
+// large base, such as the Unicode Digitizer, are always compact.
+func WithCompactNodes(enabled bool) func(*Option) {
+	return func(options *Option) {
+		options.nodeLayout = denseLayout
+		if enabled {
+			options.nodeLayout = compactLayout
+		}
+	}
 }
 
 // WithDigitizer sets the Digitizer Option for the Trie.
@@ -11,3 +39,68 @@ func WithDigitizer(digitizer Digitizer) func(*Option) {
 		options.digitizer = digitizer
 	}
 }
+
+// WithMaxEntries sets the Option for the maximum number of entries the Trie can hold. Once the limit is reached, further
+// insertions fail with hold.ErrCapacityReached.
+//
+// A limit of zero, which is the default, means the Trie is unbounded.
+func WithMaxEntries(n int) func(*Option) {
+	return func(options *Option) {
+		options.maxEntries = n
+	}
+}
+
+// WithSubtreeCounts sets the Option for whether each node of the Trie tracks the number of entries in its subtree. The
+// counts are updated as entries are added and removed, which lets CountCompletions and SubtreeSize run in time
+// proportional to the length of the prefix rather than the size of the subtree, at the cost of updating the count of
+// each node on the path to an entry when it is added or removed.
+//
+// Subtree counts are disabled by default.
+func WithSubtreeCounts(enabled bool) func(*Option) {
+	return func(options *Option) {
+		options.subtreeCounts = enabled
+	}
+}
+
+// withNodeLayout sets the Option for the layout used by the nodes of the Trie, so that a copy of a Trie keeps the layout
+// of its nodes.
+func withNodeLayout(layout nodeLayout) func(*Option) {
+	return func(options *Option) {
+		options.nodeLayout = layout
+	}
+}
+
+// DigitizerOption is a container for optional properties that can be used to initialize a Digitizer.
+type DigitizerOption struct {
+	endOfString string
+}
+
+// WithEndOfString sets the DigitizerOption for the string used by FormatDigit to represent the end of string character.
+// A blank string is ignored.
+func WithEndOfString(s string) func(*DigitizerOption) {
+	return func(options *DigitizerOption) {
+		if strings.TrimSpace(s) != "" {
+			options.endOfString = s
+		}
+	}
+}
+
+// RangeOption is a container for optional properties that can be used to configure a range query on a Trie.
+type RangeOption struct {
+	excludeHigh bool
+	excludeLow  bool
+}
+
+// ExcludeHigh sets the RangeOption for omitting an entry equal to the upper bound from the results of a range query.
+func ExcludeHigh() func(*RangeOption) {
+	return func(options *RangeOption) {
+		options.excludeHigh = true
+	}
+}
+
+// ExcludeLow sets the RangeOption for omitting an entry equal to the lower bound from the results of a range query.
+func ExcludeLow() func(*RangeOption) {
+	return func(options *RangeOption) {
+		options.excludeLow = true
+	}
+}
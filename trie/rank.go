@@ -0,0 +1,41 @@
+package trie
+
+// rankedEntry is an Entry paired with its weight and its position in the iteration order of the Trie, which is used
+// to break ties between entries of equal weight.
+type rankedEntry struct {
+	entry  Entry
+	order  int
+	weight float64
+}
+
+// rankedHeap is a min-heap of ranked entries implementing heap.Interface, where the root is the entry that would be
+// evicted first: the lowest weight, and among equal weights the latest in iteration order.
+type rankedHeap []rankedEntry
+
+func (h rankedHeap) Len() int {
+	return len(h)
+}
+
+func (h rankedHeap) Less(i, j int) bool {
+	if h[i].weight != h[j].weight {
+		return h[i].weight < h[j].weight
+	}
+	return h[i].order > h[j].order
+}
+
+func (h rankedHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *rankedHeap) Push(x any) {
+	*h = append(*h, x.(rankedEntry))
+}
+
+func (h *rankedHeap) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = rankedEntry{}
+	*h = old[:n-1]
+	return e
+}
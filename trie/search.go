@@ -1,23 +1,80 @@
 package trie
 
 import (
+	"fmt"
 	"reflect"
+	"slices"
 	"sync"
 
 	"github.com/transientvariable/hold"
 )
 
-type searchResult int
+// SearchResult describes how a value relates to the entries in a Trie.
+type SearchResult int
 
 const (
-	Extension searchResult = iota + 1
+	// Extension indicates that the value is not in the Trie, but an entry in the Trie is a prefix of the value.
+	Extension SearchResult = iota + 1
+
+	// Greater indicates that the value is greater than an entry in the Trie.
 	Greater
+
+	// Less indicates that the value is less than an entry in the Trie.
 	Less
+
+	// Matched indicates that the value is equivalent to an entry in the Trie.
 	Matched
+
+	// Prefix indicates that the value is not in the Trie, but is a prefix of at least one entry in the Trie.
 	Prefix
+
+	// Unmatched indicates that the value neither matches, nor is a prefix or extension of, any entry in the Trie.
 	Unmatched
 )
 
+// String returns the name of the SearchResult.
+func (r SearchResult) String() string {
+	switch r {
+	case Extension:
+		return "Extension"
+	case Greater:
+		return "Greater"
+	case Less:
+		return "Less"
+	case Matched:
+		return "Matched"
+	case Prefix:
+		return "Prefix"
+	case Unmatched:
+		return "Unmatched"
+	}
+	return fmt.Sprintf("SearchResult(%d)", int(r))
+}
+
+// TraversalOrder specifies the order in which Trie.Walk visits the nodes of a Trie.
+type TraversalOrder int
+
+const (
+	// PreOrder visits the nodes depth-first, visiting each node before its children, and the children of a node in
+	// ascending order of their digit.
+	PreOrder TraversalOrder = iota + 1
+
+	// BreadthFirst visits the nodes level by level, visiting every node at a depth before any node at the next depth,
+	// and the nodes at each depth in ascending order of their digits.
+	BreadthFirst
+)
+
+// String returns the name of the TraversalOrder.
+func (o TraversalOrder) String() string {
+	switch o {
+	case PreOrder:
+		return "PreOrder"
+	case BreadthFirst:
+		return "BreadthFirst"
+	}
+	return fmt.Sprintf("TraversalOrder(%d)", int(o))
+}
+
 const childNotFound = -1
 
 var searchContextPool = sync.Pool{
@@ -85,17 +142,50 @@ func (s *searchContext) descendToIndex(index int) int {
 	return index
 }
 
-func (s *searchContext) entriesInSubtree(collection hold.Collection[string]) error {
+func (s *searchContext) countInSubtree() int {
+	var count int
 	if s.atLeaf() {
-		if err := collection.Add(s.pointer.Value().Value()); err != nil {
+		count++
+	}
+
+	for i := s.pointer.NextChildIndex(0); i != childNotFound; i = s.pointer.NextChildIndex(i + 1) {
+		if s.descendToIndex(i) != childNotFound {
+			count += s.countInSubtree()
+			s.ascend()
+		}
+	}
+	return count
+}
+
+// leavesInSubtree appends each leaf in the subtree to the provided slice in iteration order, and returns the extended
+// slice.
+func (s *searchContext) leavesInSubtree(leaves []Leaf) []Leaf {
+	if l, ok := s.pointer.(Leaf); ok {
+		leaves = append(leaves, l)
+	}
+
+	for i := s.pointer.NextChildIndex(0); i != childNotFound; i = s.pointer.NextChildIndex(i + 1) {
+		if s.descendToIndex(i) != childNotFound {
+			leaves = s.leavesInSubtree(leaves)
+			s.ascend()
+		}
+	}
+	return leaves
+}
+
+// visitSubtree calls the provided function with the Entry of each leaf in the subtree in iteration order, stopping at
+// the first non-nil error. A leaf is visited before its own children, which only exist for a Digitizer that is not
+// prefix-free.
+func (s *searchContext) visitSubtree(fn func(Entry) error) error {
+	if s.atLeaf() {
+		if err := fn(s.pointer.Value()); err != nil {
 			return err
 		}
-		return nil
 	}
 
-	for i := 0; i < s.digitizer.Base(); i++ {
+	for i := s.pointer.NextChildIndex(0); i != childNotFound; i = s.pointer.NextChildIndex(i + 1) {
 		if s.descendToIndex(i) != childNotFound {
-			if err := s.entriesInSubtree(collection); err != nil {
+			if err := s.visitSubtree(fn); err != nil {
 				return err
 			}
 			s.ascend()
@@ -104,6 +194,69 @@ func (s *searchContext) entriesInSubtree(collection hold.Collection[string]) err
 	return nil
 }
 
+// visitSubtreeReverse is like visitSubtree, but visits the leaves in the subtree in reverse iteration order, such that a
+// leaf is visited after its own children.
+func (s *searchContext) visitSubtreeReverse(fn func(Entry) error) error {
+	for i := s.pointer.PreviousChildIndex(s.digitizer.Base() - 1); i != childNotFound; i = s.pointer.PreviousChildIndex(i - 1) {
+		if s.descendToIndex(i) != childNotFound {
+			if err := s.visitSubtreeReverse(fn); err != nil {
+				return err
+			}
+			s.ascend()
+		}
+	}
+
+	if s.atLeaf() {
+		return fn(s.pointer.Value())
+	}
+	return nil
+}
+
+// fuzzyMatches appends the entries in the subtree whose edit distance from the provided query digits is at most
+// maxDistance to the provided collection. The row at rows[depth] holds the edit distances between the digits on the
+// path to the current node and each prefix of the query, and subtrees are pruned once every distance in the row
+// exceeds maxDistance.
+func (s *searchContext) fuzzyMatches(query []int, rows [][]int, maxDistance int, collection hold.Collection[string]) error {
+	depth := s.branchPosition
+	row := rows[depth]
+	for i := s.pointer.NextChildIndex(0); i != childNotFound; i = s.pointer.NextChildIndex(i + 1) {
+		next := row
+		if i != 0 || !s.digitizer.IsPrefixFree() {
+			if len(rows) == depth+1 {
+				rows = append(rows, make([]int, len(row)))
+			}
+
+			next = rows[depth+1]
+			next[0] = row[0] + 1
+			for j := 1; j < len(row); j++ {
+				cost := 1
+				if query[j-1] == i {
+					cost = 0
+				}
+				next[j] = min(row[j]+1, next[j-1]+1, row[j-1]+cost)
+			}
+		}
+
+		if s.descendToIndex(i) == childNotFound {
+			continue
+		}
+
+		if s.atLeaf() && next[len(next)-1] <= maxDistance {
+			if err := collection.Add(s.pointer.Value().Value()); err != nil {
+				return err
+			}
+		}
+
+		if s.pointer.HasChildren() && slices.Min(next) <= maxDistance {
+			if err := s.fuzzyMatches(query, rows, maxDistance, collection); err != nil {
+				return err
+			}
+		}
+		s.ascend()
+	}
+	return nil
+}
+
 func (s *searchContext) extendPath(value string, node Node) (int, error) {
 	index, err := s.digitizer.DigitOf(value, s.branchPosition)
 	if err != nil {
@@ -116,17 +269,58 @@ func (s *searchContext) extendPath(value string, node Node) (int, error) {
 	return s.descendToIndex(index), nil
 }
 
+// wildcardMatches appends the entries in the subtree that match the provided pattern digits to the provided
+// collection, where a digit equal to wildcard matches any digit other than the end of string digit.
+func (s *searchContext) wildcardMatches(pattern []int, wildcard int, collection hold.Collection[string]) error {
+	if s.atLeaf() && s.branchPosition == len(pattern) {
+		return collection.Add(s.pointer.Value().Value())
+	}
+
+	if s.branchPosition >= len(pattern) {
+		return nil
+	}
+
+	digit := pattern[s.branchPosition]
+	if digit != wildcard {
+		if s.descendToIndex(digit) != childNotFound {
+			if err := s.wildcardMatches(pattern, wildcard, collection); err != nil {
+				return err
+			}
+			s.ascend()
+		}
+		return nil
+	}
+
+	from := 0
+	if s.digitizer.IsPrefixFree() {
+		from = 1
+	}
+
+	for i := s.pointer.NextChildIndex(from); i != childNotFound; i = s.pointer.NextChildIndex(i + 1) {
+		if s.descendToIndex(i) != childNotFound {
+			if err := s.wildcardMatches(pattern, wildcard, collection); err != nil {
+				return err
+			}
+			s.ascend()
+		}
+	}
+	return nil
+}
+
 func (s *searchContext) moveToMaxDescendant() {
-	for !s.atLeaf() {
-		index := s.digitizer.Base() - 1
-		for s.descendToIndex(index) == childNotFound {
-			index--
+	for s.pointer.HasChildren() {
+		if s.descendToIndex(s.pointer.PreviousChildIndex(s.digitizer.Base()-1)) == childNotFound {
+			return
 		}
 	}
 }
 
 // TODO: method argument still needed?
 func (s *searchContext) processedEndOfString(_ string) (bool, error) {
+	if s.atRoot() {
+		return false, nil
+	}
+
 	childNode, err := s.pointer.Parent().ChildAt(0)
 	if err != nil {
 		return false, err
@@ -136,18 +330,29 @@ func (s *searchContext) processedEndOfString(_ string) (bool, error) {
 		reflect.DeepEqual(childNode, s.pointer), nil
 }
 
+// retraceToLastLeftFork moves the pointer to the last leaf in iteration order that is less than the provided value,
+// starting from the node at which a search for the value ended. The pointer is left at the root if there is no such
+// leaf.
+//
+// For a Digitizer that is not prefix-free, a leaf on the path to the value is a prefix of the value, so it is the
+// predecessor of the value unless one of its children before the path is.
 func (s *searchContext) retraceToLastLeftFork(value string) error {
+	numDigits := s.digitizer.NumDigitsOf(value)
 	for {
-		if !s.atLeaf() {
+		if s.branchPosition < numDigits {
 			index, err := s.digitizer.DigitOf(value, s.branchPosition)
 			if err != nil {
 				return err
 			}
 
-			for i := index - 1; i >= 0; i-- {
-				if s.descendToIndex(i) != childNotFound {
-					return nil
-				}
+			if i := s.pointer.PreviousChildIndex(index - 1); i != childNotFound {
+				s.descendToIndex(i)
+				s.moveToMaxDescendant()
+				return nil
+			}
+
+			if s.atLeaf() {
+				return nil
 			}
 		}
 
@@ -0,0 +1,671 @@
+package trie
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+)
+
+var _ Trie = (*SyncTrie)(nil)
+
+// SyncTrie is a Trie that guards access to an underlying Trie with a sync.RWMutex, making it safe for concurrent use
+// by multiple goroutines.
+//
+// Methods that only read the Trie acquire the read lock, so lookups such as Contains, Completions, and Entries can run
+// concurrently, while methods that modify it acquire the write lock.
+//
+// The iterators returned by the SyncTrie traverse the underlying Trie directly and are not guarded by the lock, so
+// they must not be used while the SyncTrie is being modified by another goroutine. Likewise, functions passed to the
+// SyncTrie (e.g. to ForEach or TopCompletions) are called while the lock is held, and must not call back into the
+// SyncTrie.
+type SyncTrie struct {
+	mutex sync.RWMutex
+	trie  Trie
+}
+
+// Synchronized creates a new SyncTrie that wraps the provided Trie. If the provided Trie is nil, an empty Trie created
+// with the default options is used. If the provided Trie is already a SyncTrie, it is returned as is.
+//
+// The provided Trie should not be accessed directly once it has been wrapped.
+func Synchronized(t Trie) *SyncTrie {
+	if s, ok := t.(*SyncTrie); ok {
+		return s
+	}
+
+	if t == nil {
+		t, _ = New()
+	}
+	return &SyncTrie{trie: t}
+}
+
+// Add inserts the provided values into the SyncTrie.
+func (s *SyncTrie) Add(values ...string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.Add(values...)
+}
+
+// AddAll inserts all values from the provided collection into the SyncTrie.
+func (s *SyncTrie) AddAll(collection hold.Collection[string]) error {
+	if collection == nil {
+		return nil
+	}
+
+	values := list.List[string](collection.Values())
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.AddAll(&values)
+}
+
+// AddEntry inserts the provided Entry into the SyncTrie.
+func (s *SyncTrie) AddEntry(entry Entry) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.AddEntry(entry)
+}
+
+// AddAllEntries inserts the provided collection of entries into the SyncTrie.
+func (s *SyncTrie) AddAllEntries(entries hold.Collection[Entry]) error {
+	if entries == nil {
+		return nil
+	}
+
+	values := list.List[Entry](entries.Values())
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.AddAllEntries(&values)
+}
+
+// AddBytes inserts the provided binary keys into the SyncTrie, each of which is stored as the value of an Entry.
+func (s *SyncTrie) AddBytes(keys ...[]byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.AddBytes(keys...)
+}
+
+// Clear removes all entries from the SyncTrie.
+func (s *SyncTrie) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.trie.Clear()
+}
+
+// Classify returns a SearchResult describing how the provided value relates to the entries in the SyncTrie.
+func (s *SyncTrie) Classify(value string) (SearchResult, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Classify(value)
+}
+
+// Clone returns a new SyncTrie wrapping a clone of the underlying Trie.
+func (s *SyncTrie) Clone() (Trie, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	c, err := s.trie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return Synchronized(c), nil
+}
+
+// Compact releases memory retained by the nodes of the SyncTrie after entries have been removed, without changing its
+// entries.
+func (s *SyncTrie) Compact() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.trie.Compact()
+}
+
+// Compare returns a negative number when a precedes b in the iteration order of the SyncTrie, a positive number when
+// a follows b, and zero when a and b are equivalent.
+func (s *SyncTrie) Compare(a, b string) int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Compare(a, b)
+}
+
+// Completions finds all entries in the SyncTrie that match the provided prefix, and appends the matching entries
+// (if any) to the provided collection.
+func (s *SyncTrie) Completions(prefix string, entries hold.Collection[string]) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Completions(prefix, entries)
+}
+
+// CompletionEntries returns the entries in the SyncTrie that match the provided prefix in iteration order.
+func (s *SyncTrie) CompletionEntries(prefix string) ([]Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.CompletionEntries(prefix)
+}
+
+// CompletionsIterator returns a hold.Iterator that lazily visits the entries in the SyncTrie that match the provided
+// prefix in iteration order.
+//
+// The returned iterator is not guarded by the lock of the SyncTrie.
+func (s *SyncTrie) CompletionsIterator(prefix string) (hold.Iterator[string], error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.CompletionsIterator(prefix)
+}
+
+// CompletionsLimit finds at most the provided limit of entries in the SyncTrie that match the provided prefix, and
+// appends the matching entries (if any) to the provided collection in iteration order.
+func (s *SyncTrie) CompletionsLimit(prefix string, limit int, entries hold.Collection[string]) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.CompletionsLimit(prefix, limit, entries)
+}
+
+// Contains returns true if an entry equivalent to the provided value exists in the SyncTrie, otherwise false is
+// returned.
+func (s *SyncTrie) Contains(value string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Contains(value)
+}
+
+// ContainsAll returns true if an entry equivalent to each of the provided values exists in the SyncTrie, otherwise
+// false is returned.
+func (s *SyncTrie) ContainsAll(values ...string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.ContainsAll(values...)
+}
+
+// ContainsAny returns true if an entry equivalent to at least one of the provided values exists in the SyncTrie,
+// otherwise false is returned.
+func (s *SyncTrie) ContainsAny(values ...string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.ContainsAny(values...)
+}
+
+// ContainsBytes returns true if an entry equivalent to the provided binary key exists in the SyncTrie, otherwise false
+// is returned.
+func (s *SyncTrie) ContainsBytes(key []byte) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.ContainsBytes(key)
+}
+
+// ContainsSubstring finds all entries in the SyncTrie whose values contain the provided substring, and appends the
+// matching entries (if any) to the provided collection.
+func (s *SyncTrie) ContainsSubstring(sub string, entries hold.Collection[string]) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.ContainsSubstring(sub, entries)
+}
+
+// CountCompletions returns the number of entries in the SyncTrie that match the provided prefix.
+func (s *SyncTrie) CountCompletions(prefix string) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.CountCompletions(prefix)
+}
+
+// DescendingCompletions finds all entries in the SyncTrie that match the provided prefix, and appends the matching
+// entries (if any) to the provided collection in reverse iteration order.
+func (s *SyncTrie) DescendingCompletions(prefix string, entries hold.Collection[string]) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.DescendingCompletions(prefix, entries)
+}
+
+// DepthOf returns the depth of the branch from the root of the SyncTrie to the Entry corresponding to the provided
+// value.
+func (s *SyncTrie) DepthOf(value string) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.DepthOf(value)
+}
+
+// Entry returns the entry corresponding to the provided value.
+func (s *SyncTrie) Entry(value string) (Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Entry(value)
+}
+
+// Entries returns a slice containing the entries in the SyncTrie in iteration order.
+func (s *SyncTrie) Entries() ([]Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Entries()
+}
+
+// ExportDOT writes the structure of the SyncTrie to the provided writer as a Graphviz DOT graph.
+func (s *SyncTrie) ExportDOT(w io.Writer) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.ExportDOT(w)
+}
+
+// ForEach calls the provided function for each entry in the SyncTrie in iteration order.
+//
+// The read lock is held while the function is called, so the function must not modify the SyncTrie.
+func (s *SyncTrie) ForEach(fn func(string) error) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.ForEach(fn)
+}
+
+// FuzzyMatch finds all entries in the SyncTrie whose Levenshtein distance from the provided query is at most
+// maxDistance, and appends the matching entries (if any) to the provided collection in iteration order.
+func (s *SyncTrie) FuzzyMatch(query string, maxDistance int, entries hold.Collection[string]) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.FuzzyMatch(query, maxDistance, entries)
+}
+
+// Get returns the data associated with the Entry corresponding to the provided key and true. If the SyncTrie does not
+// contain such an Entry, nil and false are returned.
+func (s *SyncTrie) Get(key string) (any, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Get(key)
+}
+
+// GobDecode replaces the entries in the SyncTrie with the entries from data produced by GobEncode or MarshalBinary.
+func (s *SyncTrie) GobDecode(data []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.GobDecode(data)
+}
+
+// GobEncode encodes the entries in the SyncTrie, along with their data, in the same form as MarshalBinary.
+func (s *SyncTrie) GobEncode() ([]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.GobEncode()
+}
+
+// HasPrefix returns true if at least one entry in the SyncTrie matches the provided prefix, otherwise false is
+// returned.
+func (s *SyncTrie) HasPrefix(prefix string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.HasPrefix(prefix)
+}
+
+// Height returns the number of digits in the longest entry in the SyncTrie.
+func (s *SyncTrie) Height() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Height()
+}
+
+// IsEmpty returns true if the SyncTrie contains no entries, otherwise false is returned.
+func (s *SyncTrie) IsEmpty() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.IsEmpty()
+}
+
+// Iterate returns the hold.Iterator for the SyncTrie.
+//
+// The returned iterator is not guarded by the lock of the SyncTrie.
+func (s *SyncTrie) Iterate() hold.Iterator[string] {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Iterate()
+}
+
+// IterateFrom returns a hold.BidirectionalIterator positioned before the least entry in the SyncTrie that is greater
+// than or equal to the provided value.
+//
+// The returned iterator is not guarded by the lock of the SyncTrie.
+func (s *SyncTrie) IterateFrom(value string) (hold.BidirectionalIterator[string], error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.IterateFrom(value)
+}
+
+// IterateReverse returns a hold.BidirectionalIterator positioned after the last entry in the SyncTrie.
+//
+// The returned iterator is not guarded by the lock of the SyncTrie.
+func (s *SyncTrie) IterateReverse() hold.BidirectionalIterator[string] {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.IterateReverse()
+}
+
+// Leaves returns all the entries that are immediate children of the Entry matching the provided value.
+func (s *SyncTrie) Leaves(value string) ([]Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Leaves(value)
+}
+
+// Len returns the number of entries in the SyncTrie.
+func (s *SyncTrie) Len() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Len()
+}
+
+// LongestCommonPrefix finds all entries in the SyncTrie that share the longest common prefix with the provided
+// prefix, and appends the matching entries (if any) to the provided collection.
+func (s *SyncTrie) LongestCommonPrefix(prefix string, entries hold.Collection[string]) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.LongestCommonPrefix(prefix, entries)
+}
+
+// LongestCommonPrefixEntries returns the entries in the SyncTrie that share the longest common prefix with the
+// provided prefix in iteration order.
+func (s *SyncTrie) LongestCommonPrefixEntries(prefix string) ([]Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.LongestCommonPrefixEntries(prefix)
+}
+
+// LongestPrefixMatch returns the Entry with the longest value in the SyncTrie that is a prefix of the provided query.
+func (s *SyncTrie) LongestPrefixMatch(query string) (Entry, bool, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.LongestPrefixMatch(query)
+}
+
+// MarshalBinary encodes the entries in the SyncTrie, along with their data, into a binary form that can be restored
+// using Load.
+func (s *SyncTrie) MarshalBinary() ([]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.MarshalBinary()
+}
+
+// MarshalJSON returns the JSON encoding of the SyncTrie as an array of objects in iteration order, each holding the
+// value of an Entry and its data.
+func (s *SyncTrie) MarshalJSON() ([]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.MarshalJSON()
+}
+
+// Max returns the last entry in the SyncTrie in iteration order.
+func (s *SyncTrie) Max() (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Max()
+}
+
+// Merge inserts all entries from the provided Trie, along with their data, into the SyncTrie.
+func (s *SyncTrie) Merge(other Trie) error {
+	other, err := s.snapshot(other)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.Merge(s.unwrapSelf(other))
+}
+
+// MergeOverwrite inserts all entries from the provided Trie, along with their data, into the SyncTrie, replacing the
+// entries in the SyncTrie that are equivalent to an entry in the provided Trie.
+func (s *SyncTrie) MergeOverwrite(other Trie) error {
+	other, err := s.snapshot(other)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.MergeOverwrite(s.unwrapSelf(other))
+}
+
+// Min returns the first entry in the SyncTrie in iteration order.
+func (s *SyncTrie) Min() (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Min()
+}
+
+// Nearest returns the Entry corresponding to the provided value if the SyncTrie contains one, otherwise the nearer of
+// the predecessor and successor of the value in iteration order is returned.
+func (s *SyncTrie) Nearest(value string) (Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Nearest(value)
+}
+
+// PathString returns the digits on the path from the root of the SyncTrie to the provided node, formatted using
+// Digitizer.FormatDigit and concatenated in order.
+func (s *SyncTrie) PathString(n Node) (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.PathString(n)
+}
+
+// Predecessor returns the entry (if any) from the SyncTrie that is less than the provided value.
+func (s *SyncTrie) Predecessor(value string) (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Predecessor(value)
+}
+
+// Put associates the provided data with the provided key, inserting a new Entry or replacing the data of the existing
+// Entry.
+func (s *SyncTrie) Put(key string, data any) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.Put(key, data)
+}
+
+// Range finds all entries in the SyncTrie that fall between the provided lower and upper bounds in iteration order,
+// and appends the matching entries (if any) to the provided collection.
+func (s *SyncTrie) Range(low, high string, entries hold.Collection[string], options ...func(*RangeOption)) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Range(low, high, entries, options...)
+}
+
+// RankedCompletions returns the entries in the SyncTrie that match the provided prefix, ordered from the highest to the
+// lowest score as computed by the provided function.
+func (s *SyncTrie) RankedCompletions(prefix string, score func(prefix string, e Entry) float64) ([]Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.RankedCompletions(prefix, score)
+}
+
+// Remove removes the entry (if any) corresponding to the provided value.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (s *SyncTrie) Remove(value string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.Remove(value)
+}
+
+// RemoveAt removes the Entry at the position specified by the provided index in iteration order from the SyncTrie and
+// returns it.
+func (s *SyncTrie) RemoveAt(index int) (Entry, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.RemoveAt(index)
+}
+
+// RemoveEntry removes the entry (if any) corresponding to the provided Entry.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (s *SyncTrie) RemoveEntry(entry Entry) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.RemoveEntry(entry)
+}
+
+// RemoveEntryFunc removes the first Entry in iteration order (if any) for which the provided predicate returns true.
+//
+// If an entry was removed, the return value will be true, otherwise false will be returned.
+func (s *SyncTrie) RemoveEntryFunc(pred func(Entry) bool) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.RemoveEntryFunc(pred)
+}
+
+// RemoveMax removes the last Entry in the iteration order from the SyncTrie and returns it.
+func (s *SyncTrie) RemoveMax() (Entry, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.RemoveMax()
+}
+
+// RemoveMin removes the first Entry in the iteration order from the SyncTrie and returns it.
+func (s *SyncTrie) RemoveMin() (Entry, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.RemoveMin()
+}
+
+// RemovePrefix removes all entries in the SyncTrie that match the provided prefix, and returns the number of entries
+// that were removed.
+func (s *SyncTrie) RemovePrefix(prefix string) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.RemovePrefix(prefix)
+}
+
+// Stats returns the TrieStats describing the shape of the SyncTrie, computed in a single traversal of its nodes.
+func (s *SyncTrie) Stats() TrieStats {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Stats()
+}
+
+// SubtreeSize returns the number of entries in the SyncTrie that match the provided prefix, which is read from the
+// subtree count of the node for the prefix.
+func (s *SyncTrie) SubtreeSize(prefix string) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.SubtreeSize(prefix)
+}
+
+// Successor returns the entry (if any) from the SyncTrie that is greater than the provided value.
+func (s *SyncTrie) Successor(value string) (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Successor(value)
+}
+
+// SuffixMatch finds all entries in the SyncTrie whose values end with the provided suffix, and appends the matching
+// entries (if any) to the provided collection.
+func (s *SyncTrie) SuffixMatch(suffix string, entries hold.Collection[string]) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.SuffixMatch(suffix, entries)
+}
+
+// Take removes the Entry corresponding to the provided value from the SyncTrie and returns it, including the data
+// associated with the Entry.
+func (s *SyncTrie) Take(value string) (Entry, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.Take(value)
+}
+
+// TopCompletions returns at most n entries in the SyncTrie that match the provided prefix, ordered from the highest to
+// the lowest weight as computed by the provided function.
+func (s *SyncTrie) TopCompletions(prefix string, n int, weight func(Entry) float64) ([]Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.TopCompletions(prefix, n, weight)
+}
+
+// UnmarshalJSON replaces the entries in the SyncTrie with the entries decoded from the JSON array produced by
+// MarshalJSON.
+func (s *SyncTrie) UnmarshalJSON(data []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.UnmarshalJSON(data)
+}
+
+// Update replaces the data associated with the Entry corresponding to the provided key.
+func (s *SyncTrie) Update(key string, data any) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.trie.Update(key, data)
+}
+
+// Validate checks the consistency of the linked list of the leaves of the SyncTrie.
+func (s *SyncTrie) Validate() error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Validate()
+}
+
+// ValueAt returns the entry at the position specified by the provided index.
+func (s *SyncTrie) ValueAt(index int) (Entry, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.ValueAt(index)
+}
+
+// Values returns a slice containing the values for each Entry in the SyncTrie in iteration order.
+func (s *SyncTrie) Values() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Values()
+}
+
+// Walk calls the provided function for each node in the SyncTrie in the provided TraversalOrder, skipping the
+// descendants of a node for which the function returns false.
+func (s *SyncTrie) Walk(order TraversalOrder, visit func(depth int, n Node) bool) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.Walk(order, visit)
+}
+
+// WildcardMatch finds all entries in the SyncTrie that match the provided pattern, and appends the matching entries
+// (if any) to the provided collection in iteration order.
+func (s *SyncTrie) WildcardMatch(pattern string, wildcard byte, entries hold.Collection[string]) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.WildcardMatch(pattern, wildcard, entries)
+}
+
+// WriteTo writes the value of each Entry in the SyncTrie to the provided writer in iteration order, one value per
+// line as a double-quoted Go string literal, and returns the number of bytes written.
+func (s *SyncTrie) WriteTo(w io.Writer) (int64, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.trie.WriteTo(w)
+}
+
+// String returns a string representation of the SyncTrie in its current state.
+func (s *SyncTrie) String() string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return fmt.Sprint(s.trie)
+}
+
+// snapshot returns a copy of the provided Trie if it is a SyncTrie other than the SyncTrie itself, otherwise the
+// provided Trie is returned.
+//
+// The copy is taken under the read lock of the provided SyncTrie before the caller acquires its own lock, so that two
+// SyncTries merging into each other never hold both locks at once.
+func (s *SyncTrie) snapshot(other Trie) (Trie, error) {
+	o, ok := other.(*SyncTrie)
+	if !ok || o == s {
+		return other, nil
+	}
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	return o.trie.Clone()
+}
+
+// unwrapSelf returns the underlying Trie if the provided Trie is the SyncTrie itself, whose lock is already held by the
+// caller, otherwise the provided Trie is returned.
+func (s *SyncTrie) unwrapSelf(other Trie) Trie {
+	if other == Trie(s) {
+		return s.trie
+	}
+	return other
+}
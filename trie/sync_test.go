@@ -0,0 +1,117 @@
+package trie
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/transientvariable/hold/list"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncTrie(t *testing.T) {
+	const (
+		numWriters = 8
+		numEntries = 200
+	)
+
+	// The trie is seeded before the readers start, since Completions fails on an empty trie.
+	trie := Synchronized(nil)
+	assert.NoError(t, trie.Add("seed"))
+
+	var wg sync.WaitGroup
+	for w := 0; w < numWriters; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < numEntries; i++ {
+				assert.NoError(t, trie.Add(fmt.Sprintf("w%d-%03d", w, i)))
+			}
+		}(w)
+	}
+
+	for r := 0; r < numWriters; r++ {
+		wg.Add(1)
+		go func(r int) {
+			defer wg.Done()
+			for i := 0; i < numEntries; i++ {
+				_ = trie.Contains(fmt.Sprintf("w%d-%03d", r, i))
+				_ = trie.Len()
+
+				l := list.List[string]{}
+				assert.NoError(t, trie.Completions(fmt.Sprintf("w%d", r), &l))
+
+				_, err := trie.Entries()
+				assert.NoError(t, err)
+			}
+		}(r)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < numEntries; i++ {
+			assert.NoError(t, trie.Add("tmp"))
+			r, err := trie.Remove("tmp")
+			assert.NoError(t, err)
+			assert.True(t, r)
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, numWriters*numEntries+1, trie.Len())
+	for w := 0; w < numWriters; w++ {
+		n, err := trie.CountCompletions(fmt.Sprintf("w%d-", w))
+		assert.NoError(t, err)
+		assert.Equal(t, numEntries, n)
+	}
+
+	t.Run("Wrap", func(t *testing.T) {
+		assert.Same(t, trie, Synchronized(trie))
+
+		clone, err := trie.Clone()
+		assert.NoError(t, err)
+		assert.IsType(t, &SyncTrie{}, clone)
+		assert.Equal(t, trie.Values(), clone.Values())
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		other := Synchronized(nil)
+		assert.NoError(t, other.Add("alpha", "beta"))
+
+		merged := Synchronized(nil)
+		assert.NoError(t, merged.Add("gamma"))
+		assert.NoError(t, merged.Merge(other))
+		assertContentEquals(t, merged, "[alpha, beta, gamma]")
+
+		assert.NoError(t, merged.MergeOverwrite(merged))
+		assertContentEquals(t, merged, "[alpha, beta, gamma]")
+	})
+
+	t.Run("ConcurrentMerge", func(t *testing.T) {
+		words := benchmarkWords(20000)
+
+		a := Synchronized(nil)
+		assert.NoError(t, a.Add(words[:10000]...))
+
+		b := Synchronized(nil)
+		assert.NoError(t, b.Add(words[10000:]...))
+
+		// Merging two SyncTries into each other concurrently must not deadlock.
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, a.MergeOverwrite(b))
+			}()
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, b.MergeOverwrite(a))
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, a.Values(), b.Values())
+	})
+}
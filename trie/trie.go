@@ -1,15 +1,29 @@
 package trie
 
 import (
+	"cmp"
+	"container/heap"
+	"encoding/gob"
+	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 
 	"github.com/transientvariable/hold"
 	"github.com/transientvariable/hold/list"
 )
 
-var _ Trie = (*trie)(nil)
+var (
+	_ Trie           = (*trie)(nil)
+	_ gob.GobDecoder = (*trie)(nil)
+	_ gob.GobEncoder = (*trie)(nil)
+	_ io.WriterTo    = (*trie)(nil)
+)
+
+// errLimitReached is returned by the functions used to visit the entries in a subtree to stop visiting further entries
+// once a limit has been reached.
+var errLimitReached = errors.New("trie: limit reached")
 
 // Entry is a container for entries that can be inserted into a Trie.
 type Entry interface {
@@ -17,6 +31,14 @@ type Entry interface {
 	Data() any
 }
 
+// MutableEntry is an Entry whose data can be replaced. Put and Update replace the data of an existing MutableEntry in
+// place, so that an Entry implementation inserted into a Trie is kept, while any other Entry is replaced by a generic
+// Entry created using NewEntry.
+type MutableEntry interface {
+	Entry
+	SetData(data any)
+}
+
 // NewEntry creates a generic Entry that can be used with a Trie.
 func NewEntry(value string, data any) Entry {
 	return &entry{value: value, data: data}
@@ -45,18 +67,132 @@ type Trie interface {
 
 	// AddEntry inserts the provided Entry into the Trie.
 	//
-	// The returned error will be non-nil if the Trie has reached capacity and cannot hold any further entries.
+	// The returned error will be non-nil if the Trie has reached capacity and cannot hold any further entries. The
+	// returned error wraps ErrUnsupportedCharacter if the value of the Entry contains a character that is not supported
+	// by the Digitizer, in which case the Trie is left unchanged.
 	AddEntry(entry Entry) error
 
 	// AddAllEntries inserts the provided collection of entries into the Trie.
 	//
-	// The returned error will be non-nil if the Trie has reached capacity and cannot hold any further entries.
+	// The entries are inserted atomically: if any entry cannot be inserted, the Trie is left unchanged. The returned
+	// error will be non-nil if the Trie would exceed its capacity, or if an entry violates the prefix-free requirement.
 	AddAllEntries(entries hold.Collection[Entry]) error
 
+	// AddBytes inserts the provided binary keys into the Trie, each of which is copied into the value of an Entry. Keys
+	// are only stored unchanged when the Trie uses a ByteDigitizer.
+	//
+	// The returned error will be non-nil if the Trie has reached capacity and cannot hold any further entries, or if a
+	// key contains a byte that is not supported by the Digitizer.
+	AddBytes(keys ...[]byte) error
+
+	// Clone returns a new Trie with the same Digitizer, capacity, and entries as the Trie.
+	//
+	// The data for each Entry is copied by reference, but the nodes of the returned Trie are independent of the nodes
+	// of the Trie, so that modifying one does not affect the other.
+	Clone() (Trie, error)
+
+	// Classify returns a SearchResult describing how the provided value relates to the entries in the Trie:
+	//   - Matched if the value is equivalent to an entry
+	//   - Prefix if the value is a prefix of at least one entry
+	//   - Extension if at least one entry is a prefix of the value
+	//   - Unmatched otherwise, including when the Trie is empty
+	//
+	// When more than one classification applies, the first in the order above is returned. The returned error will be
+	// non-nil if the provided value is blank, or contains a character that is not supported by the Digitizer.
+	Classify(value string) (SearchResult, error)
+
+	// Compact releases memory retained by the nodes of the Trie after entries have been removed, without changing its
+	// entries.
+	//
+	// Nodes that only store the children that are present (those with few children, and those created for a Digitizer
+	// with a large base, such as the Unicode Digitizer) have their child storage reallocated to fit their remaining
+	// children. Nodes that have been promoted to preallocate a child slot for every digit keep their storage, since it
+	// does not grow as entries are added. Nodes left without children by a removal are already released by the removal
+	// itself.
+	Compact()
+
+	// Compare returns a negative number when a precedes b in the iteration order of the Trie, a positive number when a
+	// follows b, and zero when a and b are equivalent, so that values held outside the Trie can be sorted to match it.
+	//
+	// Values are compared by the digits produced by the Digitizer of the Trie, so the ordering follows the Digitizer
+	// rather than plain lexicographic order. Values containing characters that are not supported by the Digitizer are
+	// compared lexicographically.
+	Compare(a, b string) int
+
 	// Completions finds all entries in the Trie that match the provided prefix, and appends the matching entries
-	// (if any) to the provided collection.
+	// (if any) to the provided collection in iteration order, which is ascending by the digits of the Digitizer.
 	Completions(prefix string, entries hold.Collection[string]) error
 
+	// CompletionEntries returns the entries in the Trie that match the provided prefix in iteration order, including
+	// the data associated with each Entry.
+	//
+	// The returned error will be non-nil if the Trie is empty (has no elements).
+	CompletionEntries(prefix string) ([]Entry, error)
+
+	// CompletionsIterator returns a hold.Iterator that lazily visits the entries in the Trie that match the provided
+	// prefix in iteration order.
+	//
+	// The returned iterator is not safe for concurrent use, and should not be used while the Trie is being modified by
+	// another goroutine. The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided prefix is blank
+	CompletionsIterator(prefix string) (hold.Iterator[string], error)
+
+	// CompletionsLimit is like Completions, but stops once the provided limit of matching entries has been appended to
+	// the provided collection, without visiting the rest of the matching entries. The first matching entries in
+	// iteration order are appended, and a limit less than or equal to zero means there is no limit.
+	//
+	// The returned error will be non-nil if the Trie is empty (has no elements).
+	CompletionsLimit(prefix string, limit int, entries hold.Collection[string]) error
+
+	// ContainsAll returns true if an entry equivalent to each of the provided values exists in the Trie, otherwise false
+	// is returned.
+	//
+	// The search stops at the first value that is not found, and true is returned if no values are provided.
+	ContainsAll(values ...string) bool
+
+	// ContainsAny returns true if an entry equivalent to at least one of the provided values exists in the Trie,
+	// otherwise false is returned.
+	//
+	// The search stops at the first value that is found, and false is returned if no values are provided.
+	ContainsAny(values ...string) bool
+
+	// ContainsBytes returns true if an entry equivalent to the provided binary key exists in the Trie, otherwise false
+	// is returned.
+	//
+	// When the Trie uses a ByteDigitizer, the key is searched for without converting it to a string.
+	ContainsBytes(key []byte) bool
+
+	// ContainsSubstring finds all entries in the Trie whose values contain the provided substring, and appends the
+	// matching entries (if any) to the provided collection in iteration order.
+	//
+	// Since the Trie is organized by prefix, every entry is visited to find the matches. The returned error will be
+	// non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided substring is blank
+	ContainsSubstring(sub string, entries hold.Collection[string]) error
+
+	// CountCompletions returns the number of entries in the Trie that match the provided prefix.
+	//
+	// The returned error will be non-nil if the Trie is empty (has no elements).
+	CountCompletions(prefix string) (int, error)
+
+	// DescendingCompletions finds all entries in the Trie that match the provided prefix, and appends the matching
+	// entries (if any) to the provided collection in reverse iteration order, which is descending by the digits of the
+	// Digitizer.
+	//
+	// The returned error will be non-nil if the Trie is empty (has no elements).
+	DescendingCompletions(prefix string, entries hold.Collection[string]) error
+
+	// DepthOf returns the depth of the branch from the root of the Trie to the Entry corresponding to the provided
+	// value, which is the number of digits in the value as produced by the Digitizer.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the value provided for locating an Entry is blank
+	//   - the Trie does not contain an Entry corresponding to the provided value
+	DepthOf(value string) (int, error)
+
 	// Entry returns the entry corresponding to the provided value.
 	//
 	// The returned error will be non-nil if:
@@ -65,9 +201,76 @@ type Trie interface {
 	//   - the Trie does not contain an Entry corresponding to the provided value
 	Entry(value string) (Entry, error)
 
-	// Entries returns a slice containing the entries in the Trie in iteration order.
+	// Entries returns a slice containing the entries in the Trie in iteration order, which is ascending by the digits of
+	// the Digitizer.
 	Entries() ([]Entry, error)
 
+	// ExportDOT writes the structure of the Trie to the provided writer as a Graphviz DOT graph. Edges are labeled with
+	// the digit they represent as formatted by the Digitizer, and leaves are labeled with the value of their Entry.
+	//
+	// The returned error will be non-nil if writing to the provided writer fails.
+	ExportDOT(w io.Writer) error
+
+	// FuzzyMatch finds all entries in the Trie whose Levenshtein distance from the provided query is at most
+	// maxDistance, and appends the matching entries (if any) to the provided collection in iteration order.
+	//
+	// The distance is measured in digits as produced by the Digitizer. The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided query is blank
+	//   - the provided maxDistance is negative
+	FuzzyMatch(query string, maxDistance int, entries hold.Collection[string]) error
+
+	// ForEach calls the provided function for each entry in the Trie in iteration order.
+	//
+	// Iteration stops at the first non-nil error returned by the function, and that error is returned.
+	ForEach(fn func(string) error) error
+
+	// Get returns the data associated with the Entry corresponding to the provided key and true. If the Trie does not
+	// contain such an Entry, nil and false are returned.
+	Get(key string) (any, bool)
+
+	// GobDecode replaces the entries in the Trie with the entries from data produced by GobEncode or MarshalBinary, so
+	// that a Trie created using New can be passed to gob.Decoder.Decode.
+	//
+	// Data for each entry is decoded using encoding/gob, so concrete types other than the Go basic types must be
+	// registered with gob.Register by the caller. The returned error will be non-nil if data cannot be decoded, or if
+	// the base of the Digitizer used to produce data does not match the base of the Digitizer of the Trie, in which case
+	// the Trie is not modified.
+	GobDecode(data []byte) error
+
+	// GobEncode encodes the entries in the Trie, along with their data, in the same form as MarshalBinary, so that the
+	// Trie can be passed to gob.Encoder.Encode.
+	//
+	// Data for each entry is encoded using encoding/gob, so concrete types other than the Go basic types must be
+	// registered with gob.Register by the caller. The returned error will be non-nil if the data for any entry cannot
+	// be encoded.
+	GobEncode() ([]byte, error)
+
+	// HasPrefix returns true if at least one entry in the Trie matches the provided prefix, otherwise false is returned.
+	//
+	// An entry that is equivalent to the provided prefix also matches it. If the Trie is empty or the provided prefix is
+	// blank, false is returned.
+	HasPrefix(prefix string) bool
+
+	// Height returns the maximum depth of the branch from the root of the Trie to any Entry, or zero if the Trie is
+	// empty.
+	Height() int
+
+	// IterateFrom returns a hold.BidirectionalIterator whose cursor is positioned immediately before the least entry
+	// that is greater than or equal to the provided value, such that Next returns that entry and Previous returns its
+	// predecessor. The provided value need not correspond to an Entry, so that iteration can be resumed from the last
+	// value seen. If every entry is less than the provided value, the cursor is positioned after the last entry.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided value is blank
+	//   - the provided value contains a character that is not supported by the Digitizer
+	IterateFrom(value string) (hold.BidirectionalIterator[string], error)
+
+	// IterateReverse returns a hold.BidirectionalIterator whose cursor is positioned after the last entry in the Trie,
+	// such that successive calls to Previous visit the entries from Max to Min.
+	IterateReverse() hold.BidirectionalIterator[string]
+
 	// Leaves returns all the entries that are immediate children of the Entry matching the provided value.
 	//
 	// The returned error will be non-nil if:
@@ -80,24 +283,261 @@ type Trie interface {
 	// and appends the matching entries (if any) to the provided collection.
 	LongestCommonPrefix(prefix string, entries hold.Collection[string]) error
 
+	// LongestCommonPrefixEntries returns the entries in the Trie that share the longest common prefix with the provided
+	// prefix in iteration order, including the data associated with each Entry.
+	//
+	// The returned error will be non-nil if the Trie is empty (has no elements).
+	LongestCommonPrefixEntries(prefix string) ([]Entry, error)
+
+	// LongestPrefixMatch returns the Entry with the longest value in the Trie that is a prefix of the provided query,
+	// including the query itself, and true. If no value in the Trie is a prefix of the query, the returned Entry will be
+	// nil and false will be returned.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided query is blank
+	LongestPrefixMatch(query string) (Entry, bool, error)
+
+	// MarshalBinary encodes the entries in the Trie, along with their data, into a binary form that can be restored
+	// using Load.
+	//
+	// The returned error will be non-nil if the data for any entry cannot be encoded.
+	MarshalBinary() ([]byte, error)
+
+	// MarshalJSON returns the JSON encoding of the Trie as an array of objects in iteration order, each holding the
+	// value of an Entry as "value" and its data as "data", so that the order of the entries is preserved.
+	//
+	// The returned error will be non-nil if the data for any entry cannot be encoded.
+	MarshalJSON() ([]byte, error)
+
+	// Merge inserts all entries from the provided Trie, along with their data, into the Trie.
+	//
+	// If an error is returned, the Trie is left unchanged. The returned error will be non-nil if:
+	//   - an entry in the provided Trie contains a character that is not supported by the Digitizer for the Trie
+	//   - an entry in the provided Trie is equivalent to an entry in the Trie, or violates the prefix-free requirement
+	//   - the Trie would exceed its capacity
+	Merge(other Trie) error
+
+	// MergeOverwrite inserts all entries from the provided Trie, along with their data, into the Trie, replacing the
+	// entries in the Trie that are equivalent to an entry in the provided Trie.
+	//
+	// If an error is returned, the Trie is left unchanged. The returned error will be non-nil if:
+	//   - an entry in the provided Trie contains a character that is not supported by the Digitizer for the Trie
+	//   - an entry in the provided Trie violates the prefix-free requirement
+	//   - the Trie would exceed its capacity
+	MergeOverwrite(other Trie) error
+
+	// Nearest returns the Entry corresponding to the provided value if the Trie contains one, otherwise the nearer of
+	// the predecessor and successor of the value in iteration order is returned.
+	//
+	// The nearer Entry is the one that shares the longer prefix with the value, or if both share the same prefix, the
+	// one whose first digit after the prefix is closer to the digit of the value. If both are equally near, the
+	// predecessor is returned. The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided value is blank
+	Nearest(value string) (Entry, error)
+
+	// PathString returns the digits on the path from the root of the Trie to the provided node, formatted using
+	// Digitizer.FormatDigit and concatenated in order. The end of string digit of a prefix-free Digitizer is omitted, so
+	// the path to a leaf is the value of its Entry, except that digits the Digitizer formats with an escape (e.g. "\t")
+	// remain escaped.
+	//
+	// The returned error will be non-nil if:
+	//   - the provided node is nil
+	//   - the provided node is not attached to the root of a Trie
+	//   - a digit on the path cannot be formatted by the Digitizer
+	PathString(n Node) (string, error)
+
+	// Put associates the provided data with the provided key, inserting a new Entry if the Trie does not contain an
+	// Entry corresponding to the key, or replacing the data of the existing Entry otherwise. An existing MutableEntry is
+	// kept, and has its data replaced.
+	//
+	// The returned error will be non-nil if:
+	//   - the provided key is blank
+	//   - the provided key contains a character that is not supported by the Digitizer
+	//   - a new Entry must be inserted and the Trie has reached capacity
+	Put(key string, data any) error
+
+	// Range finds all entries in the Trie that fall between the provided lower and upper bounds in iteration order, and
+	// appends the matching entries (if any) to the provided collection. Both bounds are inclusive unless the
+	// ExcludeLow or ExcludeHigh options are provided.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - either of the provided bounds is blank
+	//   - the lower bound is greater than the upper bound
+	Range(low, high string, entries hold.Collection[string], options ...func(*RangeOption)) error
+
+	// RankedCompletions returns the entries in the Trie that match the provided prefix, ordered from the highest to the
+	// lowest score as computed by the provided function, which is called with the prefix and each matching Entry so
+	// that the score can depend on the query, such as boosting exact matches. Entries of equal score are returned in
+	// iteration order.
+	//
+	// The returned error will be non-nil if the Trie is empty (has no elements).
+	RankedCompletions(prefix string, score func(prefix string, e Entry) float64) ([]Entry, error)
+
+	// RemoveAt removes the Entry at the position specified by the provided index in iteration order from the Trie and
+	// returns it.
+	//
+	// The positions of the entries after the removed Entry are decremented by 1. The returned error will be non-nil if
+	// the provided index is outside the current bounds of the Trie (index < 0 || index > Trie.Size() - 1).
+	RemoveAt(index int) (Entry, error)
+
 	// RemoveEntry removes the first occurrence (if any) of an entry corresponding to the provided Entry.
 	//
 	// If an entry was removed, the return node will be true, otherwise false will be returned.
 	RemoveEntry(entry Entry) (bool, error)
 
+	// RemoveEntryFunc removes the first Entry in iteration order (if any) for which the provided predicate returns true,
+	// so that an Entry can be matched on its data as well as its value.
+	//
+	// If an entry was removed, the return value will be true, otherwise false will be returned. The returned error will
+	// be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided predicate is nil
+	RemoveEntryFunc(pred func(Entry) bool) (bool, error)
+
+	// RemoveMax removes the last Entry in the iteration order from the Trie and returns it, including the data
+	// associated with the Entry.
+	//
+	// The returned error will be non-nil if the Trie is empty (has no elements).
+	RemoveMax() (Entry, error)
+
+	// RemoveMin removes the first Entry in the iteration order from the Trie and returns it, including the data
+	// associated with the Entry.
+	//
+	// The returned error will be non-nil if the Trie is empty (has no elements).
+	RemoveMin() (Entry, error)
+
+	// RemovePrefix removes all entries in the Trie that match the provided prefix, and returns the number of entries
+	// that were removed.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided prefix is blank
+	RemovePrefix(prefix string) (int, error)
+
+	// Stats returns the TrieStats describing the shape of the Trie, computed in a single traversal of its nodes.
+	Stats() TrieStats
+
+	// SubtreeSize returns the number of entries in the Trie that match the provided prefix, which is read from the
+	// subtree count of the node for the prefix in time proportional to the length of the prefix.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie was not created using WithSubtreeCounts
+	//   - the Trie is empty (has no elements)
+	//   - the provided prefix is blank
+	SubtreeSize(prefix string) (int, error)
+
+	// SuffixMatch finds all entries in the Trie whose values end with the provided suffix, and appends the matching
+	// entries (if any) to the provided collection in iteration order.
+	//
+	// Since the Trie is organized by prefix, every entry is visited to find the matches. The returned error will be
+	// non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided suffix is blank
+	SuffixMatch(suffix string, entries hold.Collection[string]) error
+
+	// Take removes the Entry corresponding to the provided value from the Trie and returns it, including the data
+	// associated with the Entry.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided value is blank
+	//   - the Trie does not contain an Entry corresponding to the provided value
+	Take(value string) (Entry, error)
+
+	// TopCompletions returns at most n entries in the Trie that match the provided prefix, ordered from the highest to
+	// the lowest weight as computed by the provided function. Entries of equal weight are returned in iteration order.
+	//
+	// If n is less than or equal to zero, the returned slice will be empty. The returned error will be non-nil if the
+	// Trie is empty (has no elements).
+	TopCompletions(prefix string, n int, weight func(Entry) float64) ([]Entry, error)
+
+	// UnmarshalJSON replaces the entries in the Trie with the entries decoded from the JSON array produced by
+	// MarshalJSON.
+	//
+	// Data is decoded into the types used by encoding/json for an interface value, such as float64 for numbers and
+	// map[string]any for objects. The returned error will be non-nil if data cannot be decoded, in which case the Trie
+	// is not modified, or if any of the decoded entries cannot be inserted.
+	UnmarshalJSON(data []byte) error
+
+	// Update replaces the data associated with the Entry corresponding to the provided key. An existing MutableEntry is
+	// kept, and has its data replaced.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided key is blank
+	//   - the provided key contains a character that is not supported by the Digitizer
+	//   - the Trie does not contain an Entry corresponding to the provided key
+	Update(key string, data any) error
+
+	// Validate checks the consistency of the linked list of the leaves of the Trie, which is followed for iteration, by
+	// walking it from the head to the tail, checking that the links between the leaves agree in both directions.
+	//
+	// The returned error will wrap ErrCorrupted, and describe the first inconsistency found, if:
+	//   - a leaf and the leaf before it are not linked to each other in both directions
+	//   - a leaf that has been removed from the Trie is still linked
+	//   - a leaf has no Entry, or the entries are not in iteration order
+	//   - the number of leaves differs from the number of entries in the Trie
+	Validate() error
+
 	// ValueAt returns the entry at the position specified by the provided index.
 	//
 	// The returned error will be non-nil if the provided index is outside the current bounds of the Trie
 	// (index < 0 || index > trie.Size() - 1).
 	ValueAt(index int) (Entry, error)
+
+	// Walk calls the provided function for each node in the Trie, starting with the root at depth zero, in the provided
+	// TraversalOrder. If the function returns false, the descendants of the node it was called with are not visited.
+	//
+	// The provided function must not modify the Trie or the nodes it is called with. The returned error will be non-nil
+	// if the provided TraversalOrder is not supported or the provided function is nil.
+	Walk(order TraversalOrder, visit func(depth int, n Node) bool) error
+
+	// WildcardMatch finds all entries in the Trie that match the provided pattern, where each occurrence of the provided
+	// wildcard character in the pattern matches exactly one character, and appends the matching entries (if any) to the
+	// provided collection in iteration order.
+	//
+	// The returned error will be non-nil if:
+	//   - the Trie is empty (has no elements)
+	//   - the provided pattern is blank
+	//   - the provided wildcard or pattern contains a character that is not supported by the Digitizer
+	WildcardMatch(pattern string, wildcard byte, entries hold.Collection[string]) error
+
+	// WriteTo writes the value of each Entry in the Trie to the provided writer in iteration order, one value per line,
+	// and returns the number of bytes written. Each value is written as a double-quoted Go string literal, so that a
+	// value containing a line break can be read back using strconv.Unquote.
+	//
+	// The returned error will be non-nil if writing to the provided writer fails.
+	WriteTo(w io.Writer) (int64, error)
+}
+
+// TrieStats describes the shape of a Trie, which can be used to estimate its memory usage.
+type TrieStats struct {
+	// NodeCount is the total number of nodes in the Trie, including the root and the leaves.
+	NodeCount int
+
+	// LeafCount is the number of leaves in the Trie, each of which holds an Entry.
+	LeafCount int
+
+	// MaxDepth is the depth of the deepest node in the Trie, where the root is at depth zero.
+	MaxDepth int
+
+	// AverageBranchingFactor is the average number of children of the nodes in the Trie that have children, or zero if
+	// no node has children.
+	AverageBranchingFactor float64
 }
 
 type trie struct {
-	digitizer Digitizer
-	head      Leaf
-	root      Node
-	size      int
-	tail      Leaf
+	digitizer     Digitizer
+	head          Leaf
+	maxEntries    int
+	nodeLayout    nodeLayout
+	root          Node
+	size          int
+	subtreeCounts bool
+	tail          Leaf
 }
 
 // New creates a new Trie with the provided options.
@@ -108,17 +548,17 @@ func New(options ...func(*Option)) (Trie, error) {
 	}
 
 	head := &leaf{
-		node:   newNode(0),
+		node:   newNode(0, hybridLayout),
 		isHead: true,
 	}
 
 	tail := &leaf{
-		node:   newNode(0),
+		node:   newNode(0, hybridLayout),
 		isTail: true,
 	}
 
 	head.SetNext(tail)
-	tail.SetNext(head)
+	tail.SetPrevious(head)
 
 	trie := &trie{
 		digitizer: NewASCIIDigitizer(),
@@ -132,14 +572,40 @@ func New(options ...func(*Option)) (Trie, error) {
 		}
 		trie.digitizer = opts.digitizer
 	}
+
+	if opts.maxEntries < 0 {
+		return nil, fmt.Errorf("trie: max entries must not be negative")
+	}
+	trie.maxEntries = opts.maxEntries
+	trie.nodeLayout = opts.nodeLayout
+	trie.subtreeCounts = opts.subtreeCounts
 	return trie, nil
 }
 
+// NewFromSorted creates a new Trie with the provided options, and populates it with the provided entries in a single
+// pass.
+//
+// The entries must already be sorted in the iteration order of the Trie (ascending by the digits produced by the
+// Digitizer), which allows the path from the root shared by consecutive entries to be reused and each leaf to be
+// linked directly after the previous one. The returned error will be non-nil if an entry is out of order or is
+// equivalent to the entry before it, or if the number of entries exceeds the limit set by WithMaxEntries.
+func NewFromSorted(entries []Entry, options ...func(*Option)) (Trie, error) {
+	t, err := New(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.(*trie).addSorted(entries); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
 // Add inserts the provided node into the Trie. The returned error will be non-nil if the Trie has reached capacity and
 // cannot hold any further entries.
 func (t *trie) Add(values ...string) error {
 	for _, v := range values {
-		if v = strings.TrimSpace(v); v != "" {
+		if v = t.trim(v); v != "" {
 			if err := t.AddEntry(&entry{value: v}); err != nil {
 				return err
 			}
@@ -154,7 +620,7 @@ func (t *trie) AddAll(values hold.Collection[string]) error {
 	entries := list.List[Entry]{}
 	if values != nil {
 		for _, v := range values.Values() {
-			if v = strings.TrimSpace(v); v == "" {
+			if v = t.trim(v); v == "" {
 				continue
 			}
 
@@ -168,18 +634,51 @@ func (t *trie) AddAll(values hold.Collection[string]) error {
 
 // AddEntry inserts the provided Entry into the Trie.
 //
-// The returned error will be non-nil if the Trie has reached capacity and cannot hold any further entries.
+// The returned error will be non-nil if the Trie has reached capacity and cannot hold any further entries. The returned
+// error wraps ErrUnsupportedCharacter if the value of the Entry contains a character that is not supported by the
+// Digitizer, in which case the Trie is left unchanged.
 func (t *trie) AddEntry(entry Entry) error {
 	_, err := t.insert(entry)
 	return err
 }
 
-// AddAllEntries inserts the provided collection of entries into the Trie. The returned error will be non-nil if the
-// Trie has reached capacity and cannot hold any further entries.
+// AddAllEntries inserts the provided collection of entries into the Trie.
+//
+// The entries are inserted atomically: if any entry cannot be inserted, the entries inserted before it are removed and
+// the Trie is left unchanged. The returned error will be non-nil if the Trie would exceed its capacity, or if an entry
+// violates the prefix-free requirement, in which case the error identifies the position of the entry in the provided
+// collection.
 func (t *trie) AddAllEntries(entries hold.Collection[Entry]) error {
-	if entries != nil {
-		for _, v := range entries.Values() {
-			if err := t.AddEntry(v); err != nil {
+	if entries == nil {
+		return nil
+	}
+
+	values := entries.Values()
+	if err := t.checkCapacity(len(values)); err != nil {
+		return err
+	}
+
+	added := make([]Node, 0, len(values))
+	for i, e := range values {
+		n, err := t.insert(e)
+		if err != nil {
+			for j := len(added) - 1; j >= 0; j-- {
+				_ = t.remove(added[j])
+			}
+			return fmt.Errorf("trie: could not add entry at index %d: %w", i, err)
+		}
+		added = append(added, n)
+	}
+	return nil
+}
+
+// AddBytes inserts the provided binary keys into the Trie, each of which is copied into the value of an Entry. Keys are
+// only stored unchanged when the Trie uses a ByteDigitizer. The returned error will be non-nil if the Trie has reached
+// capacity and cannot hold any further entries, or if a key contains a byte that is not supported by the Digitizer.
+func (t *trie) AddBytes(keys ...[]byte) error {
+	for _, k := range keys {
+		if v := t.trim(string(k)); v != "" {
+			if err := t.AddEntry(&entry{value: v}); err != nil {
 				return err
 			}
 		}
@@ -191,109 +690,540 @@ func (t *trie) AddAllEntries(entries hold.Collection[Entry]) error {
 func (t *trie) Clear() {
 	iter := newIterator(t, t.head)
 	for iter.advance() {
-		_ = iter.remove()
+		_ = t.remove(iter.pointer)
 	}
 }
 
-// Completions finds all entries in the Trie that match the provided prefix, and appends the matching entries (if any)
-// to the provided collection.
-func (t *trie) Completions(prefix string, entries hold.Collection[string]) error {
+// Classify returns a SearchResult describing how the provided value relates to the entries in the Trie:
+//   - Matched if the value is equivalent to an entry
+//   - Prefix if the value is a prefix of at least one entry
+//   - Extension if at least one entry is a prefix of the value
+//   - Unmatched otherwise, including when the Trie is empty
+//
+// When more than one classification applies, the first in the order above is returned. The returned error will be
+// non-nil if the provided value is blank, or contains a character that is not supported by the Digitizer.
+func (t *trie) Classify(value string) (SearchResult, error) {
+	if value = t.trim(value); value == "" {
+		return Unmatched, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
 	if t.IsEmpty() {
-		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+		return Unmatched, nil
 	}
 
 	ctx := acquireSearchContext(t.digitizer)
 	defer releaseSearchContext(ctx)
 
-	searchResult, err := t.find(ctx, prefix)
+	r, err := t.find(ctx, value)
 	if err != nil {
-		return err
+		return Unmatched, err
 	}
 
-	numDigits := t.digitizer.NumDigitsOf(prefix)
-	if t.digitizer.IsPrefixFree() {
-		numDigits--
-		eos, err := ctx.processedEndOfString(prefix)
-		if err != nil {
-			return err
-		}
+	if r == Matched {
+		return Matched, nil
+	}
 
-		if eos {
-			ctx.ascend()
-		}
+	m, err := t.moveToPrefix(ctx, value)
+	if err != nil {
+		return Unmatched, err
 	}
 
-	if searchResult == Prefix || searchResult == Matched || ctx.branchPosition == numDigits {
-		if err := ctx.entriesInSubtree(entries); err != nil {
-			return err
-		}
+	if m {
+		return Prefix, nil
 	}
-	return nil
+
+	_, ok, err := t.LongestPrefixMatch(value)
+	if err != nil {
+		return Unmatched, err
+	}
+
+	if ok {
+		return Extension, nil
+	}
+	return Unmatched, nil
 }
 
-// Contains returns true if an entry equivalent to the provided node exists in the Trie, otherwise false is returned.
-func (t *trie) Contains(value string) bool {
-	if t.IsEmpty() {
-		return false
+// Clone returns a new Trie with the same Digitizer, capacity, and entries as the Trie.
+//
+// The data for each Entry is copied by reference, but the nodes of the returned Trie are independent of the nodes of
+// the Trie, so that modifying one does not affect the other.
+func (t *trie) Clone() (Trie, error) {
+	entries, err := t.Entries()
+	if err != nil {
+		return nil, err
 	}
 
-	if value = strings.TrimSpace(value); value == "" {
-		return false
+	for i, e := range entries {
+		entries[i] = NewEntry(e.Value(), e.Data())
 	}
 
-	ctx := acquireSearchContext(t.digitizer)
-	defer releaseSearchContext(ctx)
+	c := t.emptyCopy()
+	if err := c.addSorted(entries); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
 
-	r, err := t.find(ctx, value)
+// Compact releases memory retained by the nodes of the Trie after entries have been removed, without changing its
+// entries.
+//
+// Nodes that only store the children that are present (those with few children, and those created for a Digitizer with
+// a large base, such as the Unicode Digitizer) have their child storage reallocated to fit their remaining children.
+// Nodes that have been promoted to preallocate a child slot for every digit keep their storage, since it does not grow
+// as entries are added. Nodes left without children by a removal are already released by the removal itself.
+func (t *trie) Compact() {
+	if t.root == nil {
+		return
+	}
+
+	walkPreOrder(t.root, 0, func(_ int, n Node) bool {
+		compactNode(n)
+		return true
+	})
+}
+
+// Compare returns a negative number when a precedes b in the iteration order of the Trie, a positive number when a
+// follows b, and zero when a and b are equivalent, so that values held outside the Trie can be sorted to match it.
+// Values are compared by the digits produced by the Digitizer of the Trie, so the ordering follows the Digitizer rather
+// than plain lexicographic order. Values containing characters that are not supported by the Digitizer are compared
+// lexicographically.
+func (t *trie) Compare(a, b string) int {
+	c, err := t.compare(a, b)
 	if err != nil {
-		return false
+		return strings.Compare(t.trim(a), t.trim(b))
 	}
-	return r == Matched
+	return c
 }
 
-// Entries returns a slice containing the entries in the Trie in iteration order.
-func (t *trie) Entries() ([]Entry, error) {
+// Completions finds all entries in the Trie that match the provided prefix, and appends the matching entries (if any)
+// to the provided collection in iteration order, which is ascending by the digits of the Digitizer.
+func (t *trie) Completions(prefix string, entries hold.Collection[string]) error {
+	return t.completions(prefix, func(e Entry) error {
+		return entries.Add(e.Value())
+	})
+}
+
+// CompletionEntries returns the entries in the Trie that match the provided prefix in iteration order, including the
+// data associated with each Entry.
+//
+// The returned error will be non-nil if the Trie is empty (has no elements).
+func (t *trie) CompletionEntries(prefix string) ([]Entry, error) {
 	var entries []Entry
-	iter := newIterator(t, t.head)
-	for iter.advance() {
-		entry, err := iter.get()
-		if err != nil {
-			return entries, err
-		}
-		entries = append(entries, entry)
+	err := t.completions(prefix, func(e Entry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return entries, nil
 }
 
-// Entry returns the entry corresponding to the provided node. The returned error will be non-nil if:
+// CompletionsIterator returns a hold.Iterator that lazily visits the entries in the Trie that match the provided prefix
+// in iteration order.
+//
+// The iterator starts at the first matching entry and follows the links between the leaves of the Trie until it
+// reaches an entry that does not match the prefix, so callers that only need the first few matches do not pay for
+// visiting the rest. The returned iterator is not safe for concurrent use, and should not be used while the Trie is
+// being modified by another goroutine. The returned error will be non-nil if:
 //   - the Trie is empty (has no elements)
-//   - the node provided for locating an Entry is blank
-//   - the Trie does not contain an Entry corresponding to the provided node
-func (t *trie) Entry(value string) (Entry, error) {
-	v, err := t.node(value)
+//   - the provided prefix is blank
+func (t *trie) CompletionsIterator(prefix string) (hold.Iterator[string], error) {
+	if t.IsEmpty() {
+		return nil, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if prefix = t.trim(prefix); prefix == "" {
+		return nil, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	digits, err := t.appendDigits(nil, prefix)
 	if err != nil {
 		return nil, err
 	}
-	return v.Value(), nil
-}
 
-// IsEmpty returns true if the Trie contains no entries, otherwise false is returned.
-func (t *trie) IsEmpty() bool {
-	return t.Len() == 0
-}
+	if t.digitizer.IsPrefixFree() {
+		digits = digits[:len(digits)-1]
+	}
 
-// Iterate returns the collection.Iterator for the Trie.
-func (t *trie) Iterate() hold.Iterator[string] {
-	return newIterator(t, t.head)
-}
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
 
-// Leaves returns all the entries that are immediate children of the Entry matching the provided value. The returned
-// error will be non-nil if:
-//   - the Trie is empty (has no elements)
-//   - the value provided for locating an Entry is blank
-//   - the Trie does not contain an Entry corresponding to the provided value
-func (t *trie) Leaves(value string) ([]Entry, error) {
-	n, err := t.node(value)
+	l, err := t.ceiling(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	return newPrefixIterator(newIterator(t, l.Previous()), digits), nil
+}
+
+// CompletionsLimit is like Completions, but stops once the provided limit of matching entries has been appended to the
+// provided collection, without visiting the rest of the matching entries. The first matching entries in iteration
+// order are appended, and a limit less than or equal to zero means there is no limit. The returned error will be
+// non-nil if the Trie is empty (has no elements).
+func (t *trie) CompletionsLimit(prefix string, limit int, entries hold.Collection[string]) error {
+	if limit <= 0 {
+		return t.Completions(prefix, entries)
+	}
+
+	var n int
+	err := t.completions(prefix, func(e Entry) error {
+		if err := entries.Add(e.Value()); err != nil {
+			return err
+		}
+
+		if n++; n == limit {
+			return errLimitReached
+		}
+		return nil
+	})
+
+	if errors.Is(err, errLimitReached) {
+		return nil
+	}
+	return err
+}
+
+// CountCompletions returns the number of entries in the Trie that match the provided prefix. If the Trie was created
+// using WithSubtreeCounts, the number is read from the subtree count of the node for the prefix rather than counted.
+//
+// The returned error will be non-nil if the Trie is empty (has no elements).
+func (t *trie) CountCompletions(prefix string) (int, error) {
+	if t.IsEmpty() {
+		return 0, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	m, err := t.moveToPrefix(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	if !m {
+		return 0, nil
+	}
+
+	if t.subtreeCounts {
+		return *subtreeCount(ctx.pointer), nil
+	}
+	return ctx.countInSubtree(), nil
+}
+
+// Contains returns true if an entry equivalent to the provided node exists in the Trie, otherwise false is returned.
+func (t *trie) Contains(value string) bool {
+	if t.IsEmpty() {
+		return false
+	}
+
+	if value = t.trim(value); value == "" {
+		return false
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+	return t.contains(ctx, value)
+}
+
+// ContainsAll returns true if an entry equivalent to each of the provided values exists in the Trie, otherwise false
+// is returned. The search stops at the first value that is not found, and true is returned if no values are provided.
+func (t *trie) ContainsAll(values ...string) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	if t.IsEmpty() {
+		return false
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	for _, v := range values {
+		if !t.contains(ctx, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny returns true if an entry equivalent to at least one of the provided values exists in the Trie, otherwise
+// false is returned. The search stops at the first value that is found, and false is returned if no values are
+// provided.
+func (t *trie) ContainsAny(values ...string) bool {
+	if t.IsEmpty() {
+		return false
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	for _, v := range values {
+		if t.contains(ctx, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsBytes returns true if an entry equivalent to the provided binary key exists in the Trie, otherwise false is
+// returned. When the Trie uses a ByteDigitizer, the key is searched for without converting it to a string.
+func (t *trie) ContainsBytes(key []byte) bool {
+	d, ok := t.digitizer.(ByteDigitizer)
+	if !ok {
+		return t.Contains(string(key))
+	}
+
+	if len(key) == 0 || t.IsEmpty() {
+		return false
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	r, err := t.findBytes(ctx, d, key)
+	return err == nil && r == Matched
+}
+
+// ContainsSubstring finds all entries in the Trie whose values contain the provided substring, and appends the matching
+// entries (if any) to the provided collection in iteration order. Since the Trie is organized by prefix, every entry is
+// visited to find the matches. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided substring is blank
+func (t *trie) ContainsSubstring(sub string, entries hold.Collection[string]) error {
+	if t.IsEmpty() {
+		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if sub = t.trim(sub); sub == "" {
+		return fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+	return t.scan(func(v string) bool { return strings.Contains(v, sub) }, entries)
+}
+
+// DescendingCompletions finds all entries in the Trie that match the provided prefix, and appends the matching entries
+// (if any) to the provided collection in reverse iteration order, which is descending by the digits of the Digitizer.
+//
+// The returned error will be non-nil if the Trie is empty (has no elements).
+func (t *trie) DescendingCompletions(prefix string, entries hold.Collection[string]) error {
+	if t.IsEmpty() {
+		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	m, err := t.moveToPrefix(ctx, prefix)
+	if err != nil || !m {
+		return err
+	}
+
+	return ctx.visitSubtreeReverse(func(e Entry) error {
+		return entries.Add(e.Value())
+	})
+}
+
+// DepthOf returns the depth of the branch from the root of the Trie to the Entry corresponding to the provided value,
+// which is the number of digits in the value as produced by the Digitizer. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the value provided for locating an Entry is blank
+//   - the Trie does not contain an Entry corresponding to the provided value
+func (t *trie) DepthOf(value string) (int, error) {
+	if t.IsEmpty() {
+		return 0, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if value = t.trim(value); value == "" {
+		return 0, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	r, err := t.find(ctx, value)
+	if err != nil {
+		return 0, err
+	}
+
+	if r != Matched {
+		return 0, fmt.Errorf("trie: %w", hold.ErrNotFound)
+	}
+	return ctx.branchPosition, nil
+}
+
+// Entries returns a slice containing the entries in the Trie in iteration order, which is ascending by the digits of the
+// Digitizer.
+//
+// The returned error will only be non-nil if the Trie has been corrupted, such that iteration reaches an Entry that
+// has been removed. The entries visited before the removed Entry are returned along with the error.
+func (t *trie) Entries() ([]Entry, error) {
+	var entries []Entry
+	iter := newIterator(t, t.head)
+	for iter.advance() {
+		entry, err := iter.get()
+		if err != nil {
+			return entries, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Entry returns the entry corresponding to the provided node. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the node provided for locating an Entry is blank
+//   - the Trie does not contain an Entry corresponding to the provided node
+func (t *trie) Entry(value string) (Entry, error) {
+	v, err := t.node(value)
+	if err != nil {
+		return nil, err
+	}
+	return v.Value(), nil
+}
+
+// FuzzyMatch finds all entries in the Trie whose Levenshtein distance from the provided query is at most
+// maxDistance, and appends the matching entries (if any) to the provided collection in iteration order. The distance is
+// measured in digits as produced by the Digitizer. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided query is blank
+//   - the provided maxDistance is negative
+func (t *trie) FuzzyMatch(query string, maxDistance int, entries hold.Collection[string]) error {
+	if t.IsEmpty() {
+		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if query = t.trim(query); query == "" {
+		return fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	if maxDistance < 0 {
+		return fmt.Errorf("trie: maximum distance must not be negative: %d", maxDistance)
+	}
+
+	digits, err := t.appendDigits(nil, query)
+	if err != nil {
+		return err
+	}
+
+	if t.digitizer.IsPrefixFree() {
+		digits = digits[:len(digits)-1]
+	}
+
+	row := make([]int, len(digits)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	t.prepareSearch(ctx)
+	return ctx.fuzzyMatches(digits, [][]int{row}, maxDistance, entries)
+}
+
+// ForEach calls the provided function for each entry in the Trie in iteration order.
+//
+// Iteration stops at the first non-nil error returned by the function, and that error is returned.
+func (t *trie) ForEach(fn func(string) error) error {
+	return hold.ForEach[string](t, fn)
+}
+
+// Get returns the data associated with the Entry corresponding to the provided key and true. If the Trie does not
+// contain such an Entry, nil and false are returned.
+func (t *trie) Get(key string) (any, bool) {
+	n, err := t.node(key)
+	if err != nil {
+		return nil, false
+	}
+	return n.Value().Data(), true
+}
+
+// HasPrefix returns true if at least one entry in the Trie matches the provided prefix, otherwise false is returned. An
+// entry that is equivalent to the provided prefix also matches it. If the Trie is empty or the provided prefix is blank,
+// false is returned.
+func (t *trie) HasPrefix(prefix string) bool {
+	if t.IsEmpty() {
+		return false
+	}
+
+	if prefix = t.trim(prefix); prefix == "" {
+		return false
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	m, err := t.moveToPrefix(ctx, prefix)
+	return err == nil && m
+}
+
+// Height returns the maximum depth of the branch from the root of the Trie to any Entry, or zero if the Trie is empty.
+func (t *trie) Height() int {
+	var height int
+	iter := newIterator(t, t.head)
+	for iter.advance() {
+		entry, err := iter.get()
+		if err != nil {
+			break
+		}
+		height = max(height, t.digitizer.NumDigitsOf(entry.Value()))
+	}
+	return height
+}
+
+// IsEmpty returns true if the Trie contains no entries, otherwise false is returned.
+func (t *trie) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Iterate returns the collection.Iterator for the Trie.
+//
+// The returned iterator also implements hold.BidirectionalIterator, with the cursor positioned before the first entry,
+// as well as hold.PeekableIterator and hold.ResettableIterator.
+func (t *trie) Iterate() hold.Iterator[string] {
+	return newIterator(t, t.head)
+}
+
+// IterateFrom returns a hold.BidirectionalIterator whose cursor is positioned immediately before the least entry that
+// is greater than or equal to the provided value, such that Next returns that entry and Previous returns its
+// predecessor. The provided value need not correspond to an Entry, so that iteration can be resumed from the last value
+// seen. If every entry is less than the provided value, the cursor is positioned after the last entry. The returned
+// error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided value is blank
+//   - the provided value contains a character that is not supported by the Digitizer
+func (t *trie) IterateFrom(value string) (hold.BidirectionalIterator[string], error) {
+	if t.IsEmpty() {
+		return nil, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if value = t.trim(value); value == "" {
+		return nil, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	l, err := t.ceiling(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	return newIterator(t, l.Previous()), nil
+}
+
+// IterateReverse returns a hold.BidirectionalIterator whose cursor is positioned after the last entry in the Trie, such
+// that successive calls to Previous visit the entries from Max to Min.
+func (t *trie) IterateReverse() hold.BidirectionalIterator[string] {
+	return newIterator(t, t.tail.Previous())
+}
+
+// Leaves returns all the entries that are immediate children of the Entry matching the provided value. The returned
+// error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the value provided for locating an Entry is blank
+//   - the Trie does not contain an Entry corresponding to the provided value
+func (t *trie) Leaves(value string) ([]Entry, error) {
+	n, err := t.node(value)
 	if err != nil {
 		return nil, err
 	}
@@ -315,114 +1245,554 @@ func (t *trie) Len() int {
 // LongestCommonPrefix finds all entries in the Trie that share the longest common prefix with the provided prefix,
 // and appends the matching entries (if any) to the provided collection.
 func (t *trie) LongestCommonPrefix(prefix string, entries hold.Collection[string]) error {
+	return t.longestCommonPrefix(prefix, func(e Entry) error {
+		return entries.Add(e.Value())
+	})
+}
+
+// LongestCommonPrefixEntries returns the entries in the Trie that share the longest common prefix with the provided
+// prefix in iteration order, including the data associated with each Entry.
+//
+// The returned error will be non-nil if the Trie is empty (has no elements).
+func (t *trie) LongestCommonPrefixEntries(prefix string) ([]Entry, error) {
+	var entries []Entry
+	err := t.longestCommonPrefix(prefix, func(e Entry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// LongestPrefixMatch returns the Entry with the longest value in the Trie that is a prefix of the provided query,
+// including the query itself, and true. If no value in the Trie is a prefix of the query, the returned Entry will be nil
+// and false will be returned. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided query is blank
+func (t *trie) LongestPrefixMatch(query string) (Entry, bool, error) {
 	if t.IsEmpty() {
-		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+		return nil, false, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if query = t.trim(query); query == "" {
+		return nil, false, fmt.Errorf("trie: %w", hold.ErrValueRequired)
 	}
 
 	ctx := acquireSearchContext(t.digitizer)
 	defer releaseSearchContext(ctx)
 
-	_, err := t.find(ctx, prefix)
+	t.prepareSearch(ctx)
+
+	var match Entry
+	numDigits := t.digitizer.NumDigitsOf(query)
+	for ctx.branchPosition < numDigits {
+		if t.digitizer.IsPrefixFree() {
+			if eos, err := ctx.pointer.ChildAt(0); err == nil && eos != nil && eos.IsLeaf() {
+				match = eos.Value()
+			}
+		}
+
+		m, err := ctx.descendTo(query)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if m == childNotFound {
+			break
+		}
+
+		if ctx.atLeaf() {
+			match = ctx.pointer.Value()
+		}
+	}
+	return match, match != nil, nil
+}
+
+// Merge inserts all entries from the provided Trie, along with their data, into the Trie.
+//
+// If an error is returned, the Trie is left unchanged. The returned error will be non-nil if:
+//   - an entry in the provided Trie contains a character that is not supported by the Digitizer for the Trie
+//   - an entry in the provided Trie is equivalent to an entry in the Trie, or violates the prefix-free requirement
+//   - the Trie would exceed its capacity
+func (t *trie) Merge(other Trie) error {
+	return t.merge(other, false)
+}
+
+// MergeOverwrite inserts all entries from the provided Trie, along with their data, into the Trie, replacing the
+// entries in the Trie that are equivalent to an entry in the provided Trie.
+//
+// If an error is returned, the Trie is left unchanged. The returned error will be non-nil if:
+//   - an entry in the provided Trie contains a character that is not supported by the Digitizer for the Trie
+//   - an entry in the provided Trie violates the prefix-free requirement
+//   - the Trie would exceed its capacity
+func (t *trie) MergeOverwrite(other Trie) error {
+	return t.merge(other, true)
+}
+
+// Min returns the entry with the lowest position in the Trie. More specifically, the first entry in the iteration
+// order is returned.
+func (t *trie) Min() (string, error) {
+	if t.IsEmpty() {
+		return "", fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+	return t.head.Next().Value().Value(), nil
+}
+
+// Max returns the entry with the highest position in the Trie. More specifically, the last entry in the iteration
+// order is returned.
+func (t *trie) Max() (string, error) {
+	if t.IsEmpty() {
+		return "", fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+	return t.tail.Previous().Value().Value(), nil
+}
+
+// Nearest returns the Entry corresponding to the provided value if the Trie contains one, otherwise the nearer of the
+// predecessor and successor of the value in iteration order is returned. The nearer Entry is the one that shares the
+// longer prefix with the value, or if both share the same prefix, the one whose first digit after the prefix is closer
+// to the digit of the value. If both are equally near, the predecessor is returned. The returned error will be non-nil
+// if:
+//   - the Trie is empty (has no elements)
+//   - the provided value is blank
+func (t *trie) Nearest(value string) (Entry, error) {
+	if t.IsEmpty() {
+		return nil, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if value = t.trim(value); value == "" {
+		return nil, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	s, err := t.ceiling(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	p := s.Previous()
+	switch {
+	case p.IsHead():
+		return s.Value(), nil
+	case s.IsTail():
+		return p.Value(), nil
+	case s.Value().Value() == value:
+		return s.Value(), nil
+	}
+
+	pPrefix, pGap, err := t.distance(value, p.Value().Value())
+	if err != nil {
+		return nil, err
+	}
+
+	sPrefix, sGap, err := t.distance(value, s.Value().Value())
+	if err != nil {
+		return nil, err
+	}
+
+	if sPrefix > pPrefix || (sPrefix == pPrefix && sGap < pGap) {
+		return s.Value(), nil
+	}
+	return p.Value(), nil
+}
+
+// PathString returns the digits on the path from the root of the Trie to the provided node, formatted using
+// Digitizer.FormatDigit and concatenated in order. The end of string digit of a prefix-free Digitizer is omitted, so the
+// path to a leaf is the value of its Entry, except that digits the Digitizer formats with an escape (e.g. "\t") remain
+// escaped. The returned error will be non-nil if:
+//   - the provided node is nil
+//   - the provided node is not attached to the root of a Trie
+//   - a digit on the path cannot be formatted by the Digitizer
+func (t *trie) PathString(n Node) (string, error) {
+	if n == nil {
+		return "", fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	var depth int
+	for p := n; !p.IsRoot(); p = p.Parent() {
+		if p.Parent() == nil {
+			return "", fmt.Errorf("trie: node is not attached to the root of a Trie: %w", hold.ErrNotFound)
+		}
+		depth++
+	}
+
+	if depth == 0 {
+		return "", nil
+	}
+
+	// Every Entry in the subtree rooted at the node shares the digits on the path to the node, so the digits are
+	// formatted from the value of the first of them.
+	value := terminalValue(n)
+
+	var b strings.Builder
+	for place := range depth {
+		if t.digitizer.IsPrefixFree() {
+			d, err := t.digitizer.DigitOf(value, place)
+			if err != nil {
+				return "", err
+			}
+
+			if d == 0 {
+				continue
+			}
+		}
+
+		digit, err := t.digitizer.FormatDigit(value, place)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(digit)
+	}
+	return b.String(), nil
+}
+
+// Predecessor returns the entry (if any) from the Trie that is less than the provided node. More specifically, the
+// entry before the first occurrence of the provided entry in iteration order is returned.
+func (t *trie) Predecessor(value string) (string, error) {
+	if t.IsEmpty() {
+		return value, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if value = t.trim(value); value == "" {
+		return value, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	r, err := t.find(ctx, value)
+	if err != nil {
+		return value, err
+	}
+
+	m, err := t.moveToPredecessor(ctx, value, r)
+	if err != nil {
+		return "", err
+	}
+
+	if m {
+		return ctx.pointer.Value().Value(), nil
+	}
+	return value, fmt.Errorf("trie: %w", hold.ErrNotFound)
+}
+
+// Put associates the provided data with the provided key, inserting a new Entry if the Trie does not contain an Entry
+// corresponding to the key, or replacing the data of the existing Entry otherwise. An existing MutableEntry is kept, and
+// has its data replaced. The returned error will be non-nil if:
+//   - the provided key is blank
+//   - the provided key contains a character that is not supported by the Digitizer
+//   - a new Entry must be inserted and the Trie has reached capacity
+func (t *trie) Put(key string, data any) error {
+	if key = t.trim(key); key == "" {
+		return fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	if err := t.checkSupported(key); err != nil {
+		return err
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	r, err := t.find(ctx, key)
 	if err != nil {
 		return err
 	}
 
-	eos, err := ctx.processedEndOfString(prefix)
+	if r == Matched {
+		setData(ctx.pointer, key, data)
+		return nil
+	}
+	_, err = t.insert(NewEntry(key, data))
+	return err
+}
+
+// Range finds all entries in the Trie that fall between the provided lower and upper bounds in iteration order, and
+// appends the matching entries (if any) to the provided collection. Both bounds are inclusive unless the ExcludeLow or
+// ExcludeHigh options are provided. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - either of the provided bounds is blank
+//   - the lower bound is greater than the upper bound
+func (t *trie) Range(low, high string, entries hold.Collection[string], options ...func(*RangeOption)) error {
+	if t.IsEmpty() {
+		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	low = t.trim(low)
+	high = t.trim(high)
+	if low == "" || high == "" {
+		return fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	opts := &RangeOption{}
+	for _, opt := range options {
+		opt(opts)
+	}
+
+	c, err := t.compare(low, high)
 	if err != nil {
 		return err
 	}
 
-	if eos {
-		ctx.ascend()
+	if c > 0 {
+		return fmt.Errorf("trie: lower bound is greater than upper bound: low = %s, high = %s", low, high)
 	}
 
-	if err := ctx.entriesInSubtree(entries); err != nil {
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	l, err := t.ceiling(ctx, low)
+	if err != nil {
 		return err
 	}
-	return nil
+
+	if opts.excludeLow && !l.IsTail() && l.Value().Value() == low {
+		l = l.Next()
+	}
+
+	for ; !l.IsTail(); l = l.Next() {
+		c, err := t.compare(l.Value().Value(), high)
+		if err != nil {
+			return err
+		}
+
+		if c > 0 || (c == 0 && opts.excludeHigh) {
+			break
+		}
+
+		if err := entries.Add(l.Value().Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RankedCompletions returns the entries in the Trie that match the provided prefix, ordered from the highest to the
+// lowest score as computed by the provided function, which is called with the prefix and each matching Entry so that
+// the score can depend on the query, such as boosting exact matches. Entries of equal score are returned in iteration
+// order.
+//
+// Unlike TopCompletions, all matching entries are retained and returned, and the score of each Entry is computed
+// exactly once. The returned error will be non-nil if the Trie is empty (has no elements).
+func (t *trie) RankedCompletions(prefix string, score func(prefix string, e Entry) float64) ([]Entry, error) {
+	prefix = t.trim(prefix)
+
+	var ranked []rankedEntry
+	err := t.completions(prefix, func(e Entry) error {
+		ranked = append(ranked, rankedEntry{entry: e, order: len(ranked), weight: score(prefix, e)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The entries are visited in iteration order, so a stable sort keeps entries of equal score in that order.
+	slices.SortStableFunc(ranked, func(a, b rankedEntry) int {
+		return cmp.Compare(b.weight, a.weight)
+	})
+
+	entries := make([]Entry, len(ranked))
+	for i, r := range ranked {
+		entries[i] = r.entry
+	}
+	return entries, nil
+}
+
+// Remove removes the first occurrence (if any) of an entry equivalent to the provided node. If an entry was
+// removed, the return node will be true, otherwise false will be returned.
+func (t *trie) Remove(value string) (bool, error) {
+	return t.RemoveEntry(&entry{value: value})
+}
+
+// RemoveAt removes the Entry at the position specified by the provided index in iteration order from the Trie and
+// returns it. The positions of the entries after the removed Entry are decremented by 1. The returned error will be
+// non-nil if the provided index is outside the current bounds of the Trie (index < 0 || index > Trie.Size() - 1).
+func (t *trie) RemoveAt(index int) (Entry, error) {
+	if err := t.checkBounds(index); err != nil {
+		return nil, err
+	}
+
+	// The leaf is located by following the links between the leaves from whichever end of the Trie is nearer.
+	var l Leaf
+	if index < t.Len()/2 {
+		l = t.head.Next()
+		for range index {
+			l = l.Next()
+		}
+	} else {
+		l = t.tail.Previous()
+		for i := t.Len() - 1; i > index; i-- {
+			l = l.Previous()
+		}
+	}
+
+	return t.removeLeaf(l)
+}
+
+// RemoveEntry removes the first occurrence (if any) of an entry corresponding to the provided Entry. If an entry
+// was removed, the return node will be true, otherwise false will be returned.
+func (t *trie) RemoveEntry(entry Entry) (bool, error) {
+	if t.IsEmpty() {
+		return false, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if entry == nil {
+		return false, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	r, err := t.find(ctx, entry.Value())
+	if err != nil {
+		return false, nil
+	}
+
+	if r != Matched {
+		return false, nil
+	}
+
+	if err := t.remove(ctx.pointer); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RemoveEntryFunc removes the first Entry in iteration order (if any) for which the provided predicate returns true, so
+// that an Entry can be matched on its data as well as its value. If an entry was removed, the return value will be
+// true, otherwise false will be returned. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided predicate is nil
+func (t *trie) RemoveEntryFunc(pred func(Entry) bool) (bool, error) {
+	if t.IsEmpty() {
+		return false, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if pred == nil {
+		return false, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	for l := t.head.Next(); !l.IsTail(); l = l.Next() {
+		if pred(l.Value()) {
+			if err := t.remove(l); err != nil {
+				return false, err
+			}
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
-// Min returns the entry with the lowest position in the Trie. More specifically, the first entry in the iteration
-// order is returned.
-func (t *trie) Min() (string, error) {
+// RemoveMax removes the last Entry in the iteration order from the Trie and returns it, including the data associated
+// with the Entry. The returned error will be non-nil if the Trie is empty (has no elements).
+func (t *trie) RemoveMax() (Entry, error) {
 	if t.IsEmpty() {
-		return "", fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+		return nil, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
 	}
-	return t.head.Next().Value().Value(), nil
+	return t.removeLeaf(t.tail.Previous())
 }
 
-// Max returns the entry with the highest position in the Trie. More specifically, the last entry in the iteration
-// order is returned.
-func (t *trie) Max() (string, error) {
+// RemoveMin removes the first Entry in the iteration order from the Trie and returns it, including the data associated
+// with the Entry. The returned error will be non-nil if the Trie is empty (has no elements).
+func (t *trie) RemoveMin() (Entry, error) {
 	if t.IsEmpty() {
-		return "", fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+		return nil, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
 	}
-	return t.tail.Previous().Value().Value(), nil
+	return t.removeLeaf(t.head.Next())
 }
 
-// Predecessor returns the entry (if any) from the Trie that is less than the provided node. More specifically, the
-// entry before the first occurrence of the provided entry in iteration order is returned.
-func (t *trie) Predecessor(value string) (string, error) {
+// RemovePrefix removes all entries in the Trie that match the provided prefix, and returns the number of entries that
+// were removed. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided prefix is blank
+func (t *trie) RemovePrefix(prefix string) (int, error) {
 	if t.IsEmpty() {
-		return value, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+		return 0, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
 	}
 
-	if value = strings.TrimSpace(value); value == "" {
-		return value, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	if prefix = t.trim(prefix); prefix == "" {
+		return 0, fmt.Errorf("trie: %w", hold.ErrValueRequired)
 	}
 
 	ctx := acquireSearchContext(t.digitizer)
 	defer releaseSearchContext(ctx)
 
-	r, err := t.find(ctx, value)
-	if err != nil {
-		return value, err
+	m, err := t.moveToPrefix(ctx, prefix)
+	if err != nil || !m {
+		return 0, err
 	}
 
-	m, err := t.moveToPredecessor(ctx, value, r)
-	if err != nil {
-		return "", err
+	// Leaves are removed in reverse iteration order so that the descendants of a leaf are removed before the leaf
+	// itself, which lets each removal prune the nodes left without children.
+	leaves := ctx.leavesInSubtree(nil)
+	for i := len(leaves) - 1; i >= 0; i-- {
+		if err := t.remove(leaves[i]); err != nil {
+			return len(leaves) - 1 - i, err
+		}
 	}
+	return len(leaves), nil
+}
 
-	if m {
-		return ctx.pointer.Value().Value(), nil
+// Stats returns the TrieStats describing the shape of the Trie, computed in a single traversal of its nodes.
+func (t *trie) Stats() TrieStats {
+	var stats TrieStats
+	if t.root == nil {
+		return stats
 	}
-	return value, fmt.Errorf("trie: %w", hold.ErrNotFound)
-}
 
-// Remove removes the first occurrence (if any) of an entry equivalent to the provided node. If an entry was
-// removed, the return node will be true, otherwise false will be returned.
-func (t *trie) Remove(value string) (bool, error) {
-	return t.RemoveEntry(&entry{value: value})
+	var parents, children int
+	walkPreOrder(t.root, 0, func(depth int, n Node) bool {
+		stats.NodeCount++
+		stats.MaxDepth = max(stats.MaxDepth, depth)
+		if n.IsLeaf() {
+			stats.LeafCount++
+		}
+
+		if n.HasChildren() {
+			parents++
+			for i := n.NextChildIndex(0); i != childNotFound; i = n.NextChildIndex(i + 1) {
+				children++
+			}
+		}
+		return true
+	})
+
+	if parents > 0 {
+		stats.AverageBranchingFactor = float64(children) / float64(parents)
+	}
+	return stats
 }
 
-// RemoveEntry removes the first occurrence (if any) of an entry corresponding to the provided Entry. If an entry
-// was removed, the return node will be true, otherwise false will be returned.
-func (t *trie) RemoveEntry(entry Entry) (bool, error) {
+// SubtreeSize returns the number of entries in the Trie that match the provided prefix, which is read from the subtree
+// count of the node for the prefix in time proportional to the length of the prefix. The returned error will be non-nil
+// if:
+//   - the Trie was not created using WithSubtreeCounts
+//   - the Trie is empty (has no elements)
+//   - the provided prefix is blank
+func (t *trie) SubtreeSize(prefix string) (int, error) {
+	if !t.subtreeCounts {
+		return 0, fmt.Errorf("trie: subtree counts are not enabled")
+	}
+
 	if t.IsEmpty() {
-		return false, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+		return 0, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
 	}
 
-	if entry == nil {
-		return false, fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	if prefix = t.trim(prefix); prefix == "" {
+		return 0, fmt.Errorf("trie: %w", hold.ErrValueRequired)
 	}
 
 	ctx := acquireSearchContext(t.digitizer)
 	defer releaseSearchContext(ctx)
 
-	r, err := t.find(ctx, entry.Value())
-	if err != nil {
-		return false, nil
-	}
-
-	if r != Matched {
-		return false, nil
-	}
-
-	if err := t.remove(ctx.pointer); err != nil {
-		return false, err
+	m, err := t.moveToPrefix(ctx, prefix)
+	if err != nil || !m {
+		return 0, err
 	}
-	return true, nil
+	return *subtreeCount(ctx.pointer), nil
 }
 
 // Successor returns the entry (if any) from the Trie that is greater than the provided node. More specifically, the
@@ -432,7 +1802,7 @@ func (t *trie) Successor(value string) (string, error) {
 		return value, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
 	}
 
-	if value = strings.TrimSpace(value); value == "" {
+	if value = t.trim(value); value == "" {
 		return value, fmt.Errorf("trie: %w", hold.ErrValueRequired)
 	}
 
@@ -469,6 +1839,130 @@ func (t *trie) Successor(value string) (string, error) {
 	return value, fmt.Errorf("trie: %w", hold.ErrNotFound)
 }
 
+// SuffixMatch finds all entries in the Trie whose values end with the provided suffix, and appends the matching entries
+// (if any) to the provided collection in iteration order. Since the Trie is organized by prefix, every entry is visited
+// to find the matches. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided suffix is blank
+func (t *trie) SuffixMatch(suffix string, entries hold.Collection[string]) error {
+	if t.IsEmpty() {
+		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if suffix = t.trim(suffix); suffix == "" {
+		return fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+	return t.scan(func(v string) bool { return strings.HasSuffix(v, suffix) }, entries)
+}
+
+// Take removes the Entry corresponding to the provided value from the Trie and returns it, including the data
+// associated with the Entry. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided value is blank
+//   - the Trie does not contain an Entry corresponding to the provided value
+func (t *trie) Take(value string) (Entry, error) {
+	n, err := t.node(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Entry is read before removal, since removing a Leaf that has children clears the Entry held by its node.
+	e := n.Value()
+	if err := t.remove(n); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// TopCompletions returns at most n entries in the Trie that match the provided prefix, ordered from the highest to the
+// lowest weight as computed by the provided function. Entries of equal weight are returned in iteration order.
+//
+// Only the n highest weighted entries seen so far are retained while the matching entries are visited, so the memory
+// used is proportional to n rather than to the number of matches. If n is less than or equal to zero, the returned
+// slice will be empty. The returned error will be non-nil if the Trie is empty (has no elements).
+func (t *trie) TopCompletions(prefix string, n int, weight func(Entry) float64) ([]Entry, error) {
+	if t.IsEmpty() {
+		return nil, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if n <= 0 {
+		return []Entry{}, nil
+	}
+
+	h := make(rankedHeap, 0, min(n, t.Len()))
+	var order int
+	err := t.completions(prefix, func(e Entry) error {
+		r := rankedEntry{entry: e, order: order, weight: weight(e)}
+		order++
+
+		if h.Len() < n {
+			heap.Push(&h, r)
+		} else if h[0].weight < r.weight {
+			h[0] = r
+			heap.Fix(&h, 0)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, h.Len())
+	for i := len(entries) - 1; i >= 0; i-- {
+		entries[i] = heap.Pop(&h).(rankedEntry).entry
+	}
+	return entries, nil
+}
+
+// Update replaces the data associated with the Entry corresponding to the provided key. An existing MutableEntry is
+// kept, and has its data replaced. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided key is blank
+//   - the provided key contains a character that is not supported by the Digitizer
+//   - the Trie does not contain an Entry corresponding to the provided key
+func (t *trie) Update(key string, data any) error {
+	if err := t.checkSupported(key); err != nil {
+		return err
+	}
+
+	n, err := t.node(key)
+	if err != nil {
+		return err
+	}
+	setData(n, t.trim(key), data)
+	return nil
+}
+
+// Validate checks the consistency of the linked list of the leaves of the Trie, which is followed for iteration, by
+// walking it from the head to the tail, checking that the links between the leaves agree in both directions. The
+// returned error will wrap ErrCorrupted, and describe the first inconsistency found, if:
+//   - a leaf and the leaf before it are not linked to each other in both directions
+//   - a leaf that has been removed from the Trie is still linked
+//   - a leaf has no Entry, or the entries are not in iteration order
+//   - the number of leaves differs from the number of entries in the Trie
+func (t *trie) Validate() error {
+	var previous Entry
+	return t.validateLinks(func(position int, l Leaf) error {
+		if l.Value() == nil {
+			return fmt.Errorf("trie: leaf at position %d has no entry: %w", position, ErrCorrupted)
+		}
+
+		if previous != nil {
+			c, err := t.compare(previous.Value(), l.Value().Value())
+			if err != nil {
+				return err
+			}
+
+			if c >= 0 {
+				return fmt.Errorf("trie: leaf at position %d is out of iteration order: previous = %s, value = %s: %w",
+					position, previous.Value(), l.Value().Value(), ErrCorrupted)
+			}
+		}
+		previous = l.Value()
+		return nil
+	})
+}
+
 // ValueAt returns the entry at the position specified by the provided index. The returned error will be
 // non-nil if the provided index is outside the current bounds of the trie (index < 0 || index > trie.Size() - 1).
 func (t *trie) ValueAt(index int) (Entry, error) {
@@ -494,80 +1988,343 @@ func (t *trie) ValueAt(index int) (Entry, error) {
 }
 
 // Values returns a slice containing the values for each Entry in the Trie in iteration order.
+//
+// Unlike Entries, Values cannot fail: if the Trie has been corrupted, such that iteration reaches an Entry that has
+// been removed, the removed Entry is skipped.
 func (t *trie) Values() []string {
-	entries, err := t.Entries()
+	values := make([]string, 0, t.Len())
+	for l := t.head.Next(); l != nil && !l.IsTail(); l = l.Next() {
+		if l.IsDeleted() || l.Value() == nil {
+			continue
+		}
+		values = append(values, l.Value().Value())
+	}
+	return values
+}
+
+// Walk calls the provided function for each node in the Trie, starting with the root at depth zero, in the provided
+// TraversalOrder. If the function returns false, the descendants of the node it was called with are not visited. The
+// provided function must not modify the Trie or the nodes it is called with. The returned error will be non-nil if the
+// provided TraversalOrder is not supported or the provided function is nil.
+func (t *trie) Walk(order TraversalOrder, visit func(depth int, n Node) bool) error {
+	if visit == nil {
+		return fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	switch order {
+	case PreOrder:
+		if t.root != nil {
+			walkPreOrder(t.root, 0, visit)
+		}
+	case BreadthFirst:
+		if t.root != nil {
+			walkBreadthFirst(t.root, visit)
+		}
+	default:
+		return fmt.Errorf("trie: unsupported traversal order: %v", order)
+	}
+	return nil
+}
+
+// WildcardMatch finds all entries in the Trie that match the provided pattern, where each occurrence of the provided
+// wildcard character in the pattern matches exactly one character, and appends the matching entries (if any) to the
+// provided collection in iteration order. The returned error will be non-nil if:
+//   - the Trie is empty (has no elements)
+//   - the provided pattern is blank
+//   - the provided wildcard or pattern contains a character that is not supported by the Digitizer
+func (t *trie) WildcardMatch(pattern string, wildcard byte, entries hold.Collection[string]) error {
+	if t.IsEmpty() {
+		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	if pattern = t.trim(pattern); pattern == "" {
+		return fmt.Errorf("trie: %w", hold.ErrValueRequired)
+	}
+
+	w, err := t.digitizer.DigitOf(string(wildcard), 0)
+	if err != nil {
+		return err
+	}
+
+	digits, err := t.appendDigits(nil, pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	t.prepareSearch(ctx)
+	return ctx.wildcardMatches(digits, w, entries)
+}
+
+// String returns a string representation of the Trie in its current state.
+func (t *trie) String() string {
+	if t.Len() == 0 {
+		return "[]"
+	}
+
+	values := make([]string, 0, t.Len())
+	iter := t.Iterate()
+	for iter.HasNext() {
+		v, err := iter.Next()
+		if err != nil {
+			return fmt.Sprintf("[error: %s]", err.Error())
+		}
+		values = append(values, fmt.Sprintf("%s", v))
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+func (t *trie) addNode(ctx *searchContext, node Node) error {
+	if ctx.pointer == nil {
+		t.root = newRootNode(t.digitizer.Base(), t.nodeLayout)
+		ctx.pointer = t.root
+	}
+
+	entry := node.Value()
+	for ctx.branchPosition < t.digitizer.NumDigitsOf(entry.Value())-1 {
+		index, err := t.digitizer.DigitOf(entry.Value(), ctx.branchPosition)
+		if err != nil {
+			return err
+		}
+
+		childNode := newNode(t.digitizer.Base(), t.nodeLayout)
+		if err := ctx.pointer.AddChild(index, childNode); err != nil {
+			return err
+		}
+		ctx.pointer = childNode
+		ctx.branchPosition++
+	}
+
+	index, err := t.digitizer.DigitOf(entry.Value(), ctx.branchPosition)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.pointer.AddChild(index, node); err != nil {
+		return err
+	}
+	ctx.pointer = node
+	ctx.branchPosition++
+	return nil
+}
+
+// ceiling returns the Leaf for the first entry in iteration order that is greater than or equal to the provided value,
+// or the tail if there is no such entry.
+func (t *trie) ceiling(ctx *searchContext, value string) (Leaf, error) {
+	r, err := t.find(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if r == Matched {
+		return ctx.pointer.(Leaf), nil
+	}
+
+	m, err := t.moveToPredecessor(ctx, value, r)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+
+	if m {
+		return ctx.pointer.(Leaf).Next(), nil
+	}
+	return t.head.Next(), nil
+}
+
+func (t *trie) addSorted(entries []Entry) error {
+	if err := t.checkCapacity(len(entries)); err != nil {
+		return err
+	}
+
+	if t.root == nil {
+		t.root = newRootNode(t.digitizer.Base(), t.nodeLayout)
 	}
 
-	values := make([]string, len(entries))
+	path := []Node{t.root}
+	var digits, previous []int
 	for i, e := range entries {
-		values[i] = e.Value()
+		if e == nil || t.trim(e.Value()) == "" {
+			return fmt.Errorf("trie: entry at index %d: %w", i, hold.ErrValueRequired)
+		}
+
+		var err error
+		if digits, err = t.appendDigits(digits[:0], e.Value()); err != nil {
+			return err
+		}
+
+		var common int
+		if i > 0 {
+			for common < len(previous) && common < len(digits) && previous[common] == digits[common] {
+				common++
+			}
+
+			if common == len(digits) || (common == len(previous) && t.digitizer.IsPrefixFree()) {
+				return fmt.Errorf("trie: entry violates prefix-free requirement: %v", e)
+			}
+
+			if common < len(previous) && digits[common] < previous[common] {
+				return fmt.Errorf("trie: entries are not sorted: index = %d, previous = %v, entry = %v", i, entries[i-1], e)
+			}
+		}
+
+		path = path[:common+1]
+		for _, d := range digits[common : len(digits)-1] {
+			childNode := newNode(t.digitizer.Base(), t.nodeLayout)
+			if err := path[len(path)-1].AddChild(d, childNode); err != nil {
+				return err
+			}
+			path = append(path, childNode)
+		}
+
+		leaf := t.newLeaf()
+		leaf.SetValue(e)
+		if err := path[len(path)-1].AddChild(digits[len(digits)-1], leaf); err != nil {
+			return err
+		}
+		path = append(path, leaf)
+		leaf.AddAfter(t.tail.Previous())
+		t.size++
+		t.updateSubtreeCounts(leaf, 1)
+		digits, previous = previous, digits
 	}
-	return values
+	return nil
+}
+
+func (t *trie) checkBounds(index int) error {
+	if index < 0 || index >= t.Len() {
+		return fmt.Errorf("trie: index out of bounds: Trie.Size() = %d, requested index = %d", t.Len(), index)
+	}
+	return nil
+}
+
+func (t *trie) checkCapacity(n int) error {
+	if t.maxEntries > 0 && t.size+n > t.maxEntries {
+		return fmt.Errorf("trie: max entries = %d, size = %d: %w", t.maxEntries, t.size, hold.ErrCapacityReached)
+	}
+	return nil
 }
 
-// String returns a string representation of the Trie in its current state.
-func (t *trie) String() string {
-	if t.Len() == 0 {
-		return "[]"
+// checkSupported returns an error wrapping ErrUnsupportedCharacter, along with the error returned by the Digitizer, if
+// the provided value contains a character that is not supported by the Digitizer.
+func (t *trie) checkSupported(value string) error {
+	value = t.trim(value)
+	if ok, place := t.digitizer.Supports(value); !ok {
+		_, err := t.digitizer.DigitOf(value, place)
+		if errors.Is(err, ErrUnsupportedCharacter) {
+			return fmt.Errorf("trie: value = %q, place = %d: %w", value, place, err)
+		}
+		return fmt.Errorf("trie: %w: value = %q, place = %d: %w", ErrUnsupportedCharacter, value, place, err)
 	}
+	return nil
+}
 
-	values := make([]string, 0, t.Len())
-	iter := t.Iterate()
-	for iter.HasNext() {
-		v, err := iter.Next()
+// compare returns an integer comparing the provided values by the sequence of digits produced by the Digitizer, which
+// is the iteration order of the Trie.
+func (t *trie) compare(a, b string) (int, error) {
+	a = t.trim(a)
+	b = t.trim(b)
+
+	numDigitsA := t.digitizer.NumDigitsOf(a)
+	numDigitsB := t.digitizer.NumDigitsOf(b)
+	for place := 0; place < min(numDigitsA, numDigitsB); place++ {
+		da, err := t.digitizer.DigitOf(a, place)
 		if err != nil {
-			return fmt.Sprintf("[error: %s]", err.Error())
+			return 0, err
+		}
+
+		db, err := t.digitizer.DigitOf(b, place)
+		if err != nil {
+			return 0, err
+		}
+
+		if da != db {
+			return cmp.Compare(da, db), nil
 		}
-		values = append(values, fmt.Sprintf("%s", v))
 	}
-	return "[" + strings.Join(values, ", ") + "]"
+	return cmp.Compare(numDigitsA, numDigitsB), nil
 }
 
-func (t *trie) addNode(ctx *searchContext, node Node) error {
-	if ctx.pointer == nil {
-		t.root = newRootNode(t.digitizer.Base())
-		ctx.pointer = t.root
-	}
+// distance returns the number of leading digits shared by the provided values, along with the absolute difference
+// between their first digits that differ, which is zero if one of the values is a prefix of the other.
+func (t *trie) distance(a, b string) (int, int, error) {
+	numDigits := min(t.digitizer.NumDigitsOf(a), t.digitizer.NumDigitsOf(b))
+	for place := 0; place < numDigits; place++ {
+		da, err := t.digitizer.DigitOf(a, place)
+		if err != nil {
+			return 0, 0, err
+		}
 
-	entry := node.Value()
-	for ctx.branchPosition < t.digitizer.NumDigitsOf(entry.Value())-1 {
-		index, err := t.digitizer.DigitOf(entry.Value(), ctx.branchPosition)
+		db, err := t.digitizer.DigitOf(b, place)
 		if err != nil {
-			return err
+			return 0, 0, err
 		}
 
-		childNode := newNode(t.digitizer.Base())
-		if err := ctx.pointer.AddChild(index, childNode); err != nil {
-			return err
+		if da != db {
+			return place, max(da-db, db-da), nil
 		}
-		ctx.pointer = childNode
-		ctx.branchPosition++
 	}
+	return numDigits, 0, nil
+}
 
-	index, err := t.digitizer.DigitOf(entry.Value(), ctx.branchPosition)
+func (t *trie) completions(prefix string, fn func(Entry) error) error {
+	if t.IsEmpty() {
+		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	m, err := t.moveToPrefix(ctx, prefix)
 	if err != nil {
 		return err
 	}
 
-	if err := ctx.pointer.AddChild(index, node); err != nil {
-		return err
+	if m {
+		return ctx.visitSubtree(fn)
 	}
-	ctx.pointer = node
-	ctx.branchPosition++
 	return nil
 }
 
-func (t *trie) checkBounds(index int) error {
-	if index < 0 || index >= t.Len() {
-		return fmt.Errorf("trie: index out of bounds: Trie.Size() = %d, requested index = %d", t.Len(), index)
+// appendDigits appends the digits of the provided value to dst and returns the extended slice.
+func (t *trie) appendDigits(dst []int, value string) ([]int, error) {
+	numDigits := t.digitizer.NumDigitsOf(value)
+	for place := 0; place < numDigits; place++ {
+		d, err := t.digitizer.DigitOf(value, place)
+		if err != nil {
+			return nil, err
+		}
+		dst = append(dst, d)
 	}
-	return nil
+	return dst, nil
+}
+
+// contains returns true if an entry equivalent to the provided value exists in the Trie, using the provided
+// searchContext for the search.
+func (t *trie) contains(ctx *searchContext, value string) bool {
+	if value = t.trim(value); value == "" {
+		return false
+	}
+
+	r, err := t.find(ctx, value)
+	return err == nil && r == Matched
+}
+
+// emptyCopy returns a new, empty Trie created with the same options as the Trie. The options of an existing Trie are
+// valid, so creating the copy cannot fail.
+func (t *trie) emptyCopy() *trie {
+	c, _ := New(
+		WithDigitizer(t.digitizer),
+		WithMaxEntries(t.maxEntries),
+		WithSubtreeCounts(t.subtreeCounts),
+		withNodeLayout(t.nodeLayout),
+	)
+	return c.(*trie)
 }
 
-func (t *trie) find(ctx *searchContext, value string) (searchResult, error) {
-	if value = strings.TrimSpace(value); value == "" {
+func (t *trie) find(ctx *searchContext, value string) (SearchResult, error) {
+	if value = t.trim(value); value == "" {
 		return -1, fmt.Errorf("trie: %w", hold.ErrNotFound)
 	}
 
@@ -579,7 +2336,11 @@ func (t *trie) find(ctx *searchContext, value string) (searchResult, error) {
 
 	numDigitsInElement := t.digitizer.NumDigitsOf(value)
 
-	for ctx.pointer != nil && !ctx.atLeaf() {
+	for ctx.pointer != nil {
+		if ctx.atLeaf() && (ctx.branchPosition == numDigitsInElement || !ctx.pointer.HasChildren()) {
+			break
+		}
+
 		if ctx.branchPosition == numDigitsInElement {
 			return Prefix, nil
 		}
@@ -590,6 +2351,48 @@ func (t *trie) find(ctx *searchContext, value string) (searchResult, error) {
 		}
 
 		if m == childNotFound {
+			if ctx.atLeaf() {
+				return Extension, nil
+			}
+			return Unmatched, nil
+		}
+	}
+
+	if ctx.pointer != nil && ctx.branchPosition != numDigitsInElement {
+		return Extension, nil
+	}
+
+	if ctx.pointer != nil && !ctx.pointer.IsTerminal() {
+		return Prefix, nil
+	}
+	return Matched, nil
+}
+
+// findBytes is the counterpart of find for binary keys, which digitizes the provided key using the provided
+// ByteDigitizer so that it is not converted to a string.
+func (t *trie) findBytes(ctx *searchContext, d ByteDigitizer, key []byte) (SearchResult, error) {
+	t.prepareSearch(ctx)
+
+	numDigitsInElement := d.NumDigitsOfBytes(key)
+
+	for ctx.pointer != nil {
+		if ctx.atLeaf() && (ctx.branchPosition == numDigitsInElement || !ctx.pointer.HasChildren()) {
+			break
+		}
+
+		if ctx.branchPosition == numDigitsInElement {
+			return Prefix, nil
+		}
+
+		index, err := d.DigitOfBytes(key, ctx.branchPosition)
+		if err != nil {
+			return -1, err
+		}
+
+		if ctx.descendToIndex(index) == childNotFound {
+			if ctx.atLeaf() {
+				return Extension, nil
+			}
 			return Unmatched, nil
 		}
 	}
@@ -597,10 +2400,18 @@ func (t *trie) find(ctx *searchContext, value string) (searchResult, error) {
 	if ctx.pointer != nil && ctx.branchPosition != numDigitsInElement {
 		return Extension, nil
 	}
+
+	if ctx.pointer != nil && !ctx.pointer.IsTerminal() {
+		return Prefix, nil
+	}
 	return Matched, nil
 }
 
 func (t *trie) insert(entry Entry) (Node, error) {
+	if err := t.checkSupported(entry.Value()); err != nil {
+		return nil, err
+	}
+
 	ctx := acquireSearchContext(t.digitizer)
 	defer releaseSearchContext(ctx)
 
@@ -609,15 +2420,26 @@ func (t *trie) insert(entry Entry) (Node, error) {
 		return nil, err
 	}
 
-	if searchResult == Matched || (!t.digitizer.IsPrefixFree() && (searchResult == Prefix || searchResult == Extension)) {
+	if searchResult == Matched {
 		return nil, fmt.Errorf("trie: entry violates prefix-free requirement: %v", entry)
 	}
 
-	leaf := newLeaf()
-	leaf.SetValue(entry)
-	if err := t.addNode(ctx, leaf); err != nil {
+	if err := t.checkCapacity(1); err != nil {
 		return nil, err
 	}
+
+	var leaf Leaf
+	if searchResult == Prefix {
+		leaf = t.promote(ctx.pointer)
+		leaf.SetValue(entry)
+		ctx.pointer = leaf
+	} else {
+		leaf = t.newLeaf()
+		leaf.SetValue(entry)
+		if err := t.addNode(ctx, leaf); err != nil {
+			return nil, err
+		}
+	}
 	searchResult = Matched
 
 	m, err := t.moveToPredecessor(ctx, entry.Value(), searchResult)
@@ -631,11 +2453,118 @@ func (t *trie) insert(entry Entry) (Node, error) {
 		leaf.AddAfter(t.head)
 	}
 	t.size++
+	t.updateSubtreeCounts(leaf, 1)
 	return leaf, nil
 }
 
-func (t *trie) moveToPredecessor(ctx *searchContext, value string, searchResult searchResult) (bool, error) {
-	if ctx.atLeaf() && (searchResult == Greater || searchResult == Extension) {
+func (t *trie) longestCommonPrefix(prefix string, fn func(Entry) error) error {
+	if t.IsEmpty() {
+		return fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	_, err := t.find(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	eos, err := ctx.processedEndOfString(prefix)
+	if err != nil {
+		return err
+	}
+
+	if eos {
+		ctx.ascend()
+	}
+	return ctx.visitSubtree(fn)
+}
+
+// merge validates every entry of the provided Trie against the Trie before modifying it, and removes the entries it
+// inserted if an insertion fails, so that an entry that cannot be merged leaves the Trie unchanged. The entries that
+// replace equivalent entries in the Trie are only set once every other entry has been inserted.
+func (t *trie) merge(other Trie, overwrite bool) error {
+	if other == nil {
+		return nil
+	}
+
+	entries, err := other.Entries()
+	if err != nil {
+		return err
+	}
+
+	ctx := acquireSearchContext(t.digitizer)
+	defer releaseSearchContext(ctx)
+
+	var added []Entry
+	replaced := make(map[Leaf]Entry)
+	for _, e := range entries {
+		if err := t.checkSupported(e.Value()); err != nil {
+			return err
+		}
+
+		r, err := t.find(ctx, e.Value())
+		if err != nil {
+			return err
+		}
+
+		if r == Matched && overwrite {
+			replaced[ctx.pointer.(Leaf)] = e
+			continue
+		}
+
+		if r == Matched {
+			return fmt.Errorf("trie: entry violates prefix-free requirement: %v", e)
+		}
+		added = append(added, e)
+	}
+
+	if err := t.checkCapacity(len(added)); err != nil {
+		return err
+	}
+
+	inserted := make([]Node, 0, len(added))
+	for _, e := range added {
+		n, err := t.insert(e)
+		if err != nil {
+			for i := len(inserted) - 1; i >= 0; i-- {
+				_ = t.remove(inserted[i])
+			}
+			return err
+		}
+		inserted = append(inserted, n)
+	}
+
+	for l, e := range replaced {
+		l.SetValue(e)
+	}
+	return nil
+}
+
+func (t *trie) moveToPrefix(ctx *searchContext, prefix string) (bool, error) {
+	searchResult, err := t.find(ctx, prefix)
+	if err != nil {
+		return false, err
+	}
+
+	numDigits := t.digitizer.NumDigitsOf(prefix)
+	if t.digitizer.IsPrefixFree() {
+		numDigits--
+		eos, err := ctx.processedEndOfString(prefix)
+		if err != nil {
+			return false, err
+		}
+
+		if eos {
+			ctx.ascend()
+		}
+	}
+	return searchResult == Prefix || searchResult == Matched || ctx.branchPosition == numDigits, nil
+}
+
+func (t *trie) moveToPredecessor(ctx *searchContext, value string, searchResult SearchResult) (bool, error) {
+	if ctx.atLeaf() && searchResult == Greater {
 		return true, nil
 	}
 
@@ -644,13 +2573,16 @@ func (t *trie) moveToPredecessor(ctx *searchContext, value string, searchResult
 			return false, err
 		}
 	}
+	return !ctx.atRoot(), nil
+}
 
-	if ctx.atRoot() {
-		return false, nil
-	} else if !ctx.atLeaf() {
-		ctx.moveToMaxDescendant()
+// newLeaf creates a Leaf for an entry in the Trie. For a Digitizer that is not prefix-free, the Leaf can hold children
+// so that the entry can be a prefix of other entries.
+func (t *trie) newLeaf() Leaf {
+	if t.digitizer.IsPrefixFree() {
+		return newLeaf(0, t.nodeLayout)
 	}
-	return true, nil
+	return newLeaf(t.digitizer.Base(), t.nodeLayout)
 }
 
 func (t *trie) node(value string) (Node, error) {
@@ -658,7 +2590,7 @@ func (t *trie) node(value string) (Node, error) {
 		return nil, fmt.Errorf("trie: %w", hold.ErrCollectionEmpty)
 	}
 
-	if value = strings.TrimSpace(value); value == "" {
+	if value = t.trim(value); value == "" {
 		return nil, fmt.Errorf("trie: %w", hold.ErrValueRequired)
 	}
 
@@ -682,9 +2614,107 @@ func (t *trie) prepareSearch(ctx *searchContext) {
 	ctx.pointer = t.root
 }
 
+// promote replaces the provided interior node with a Leaf that adopts its children, so that an entry can end at the
+// node, and returns the Leaf.
+func (t *trie) promote(n Node) Leaf {
+	l := wrapLeaf(n)
+	t.replace(n, l)
+	return l
+}
+
+// demote replaces the provided Leaf with the interior node it wraps, so that the node no longer terminates an entry but
+// keeps its children.
+func (t *trie) demote(l Leaf) {
+	if l, ok := l.(*leaf); ok {
+		t.replace(l, l.unwrap())
+	}
+}
+
+// replace swaps the provided node in the Trie for its replacement, which adopts the children of the node.
+func (t *trie) replace(n Node, replacement Node) {
+	for i := n.NextChildIndex(0); i != childNotFound; i = n.NextChildIndex(i + 1) {
+		c, _ := n.ChildAt(i)
+		c.SetParent(replacement)
+	}
+
+	parent := n.Parent()
+	for i := parent.NextChildIndex(0); i != childNotFound; i = parent.NextChildIndex(i + 1) {
+		if c, _ := parent.ChildAt(i); c == n {
+			parent.RemoveChildAt(i)
+			_ = parent.AddChild(i, replacement)
+			break
+		}
+	}
+}
+
+// removeLeaf removes the provided Leaf from the Trie and returns its Entry, which is read before removal, since removing
+// a Leaf that has children clears the Entry held by its node.
+func (t *trie) removeLeaf(l Leaf) (Entry, error) {
+	e := l.Value()
+	if err := t.remove(l); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// validateLinks walks the linked list of the leaves of the Trie from the head to the tail, checking that each leaf and
+// the leaf before it are linked to each other in both directions, which also covers the walk from the tail to the head,
+// and calling the provided function with the position of each leaf. The walk fails once more leaves than the size of
+// the Trie have been visited, so that a cycle in the links cannot prevent it from terminating.
+func (t *trie) validateLinks(visit func(position int, l Leaf) error) error {
+	count := 0
+	for l := t.head; ; {
+		n := l.Next()
+		if n == nil {
+			return fmt.Errorf("trie: leaf list is broken after %d leaves: %w", count, ErrCorrupted)
+		}
+
+		if n == t.tail {
+			if n.Previous() != l {
+				return fmt.Errorf("trie: tail is not linked back to the leaf before it: %w", ErrCorrupted)
+			}
+			break
+		}
+
+		if n.IsHead() || n.IsTail() {
+			return fmt.Errorf("trie: leaf at position %d is a head or tail leaf: %w", count, ErrCorrupted)
+		}
+
+		if n.IsDeleted() {
+			return fmt.Errorf("trie: leaf at position %d has been removed: %w", count, ErrCorrupted)
+		}
+
+		if n.Previous() != l {
+			return fmt.Errorf("trie: leaf at position %d is not linked back to the leaf before it: %w", count,
+				ErrCorrupted)
+		}
+
+		if count++; count > t.size {
+			return fmt.Errorf("trie: found more leaves than size = %d: %w", t.size, ErrCorrupted)
+		}
+
+		if err := visit(count-1, n); err != nil {
+			return err
+		}
+		l = n
+	}
+
+	if count != t.size {
+		return fmt.Errorf("trie: found %d leaves, but size = %d: %w", count, t.size, ErrCorrupted)
+	}
+	return nil
+}
+
 func (t *trie) remove(node Node) error {
+	t.updateSubtreeCounts(node, -1)
 	if leaf, ok := node.(Leaf); ok {
 		leaf.Remove()
+
+		if leaf.HasChildren() {
+			t.demote(leaf)
+			t.size--
+			return nil
+		}
 	}
 
 	entry := node.Value()
@@ -700,8 +2730,95 @@ func (t *trie) remove(node Node) error {
 		}
 
 		parent.RemoveChildAt(index)
+		if parent.IsTerminal() {
+			break
+		}
 		node = parent
 	}
 	t.size--
 	return nil
 }
+
+// scan visits each entry in the Trie in iteration order, and appends the value of each entry for which the provided
+// function returns true to the provided collection.
+func (t *trie) scan(match func(string) bool, entries hold.Collection[string]) error {
+	for l := t.head.Next(); l != nil && !l.IsTail(); l = l.Next() {
+		if l.IsDeleted() || l.Value() == nil {
+			continue
+		}
+
+		if v := l.Value().Value(); match(v) {
+			if err := entries.Add(v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// trim returns the provided value with leading and trailing whitespace removed, unless the Trie uses a ByteDigitizer,
+// for which whitespace is significant.
+func (t *trie) trim(value string) string {
+	if _, ok := t.digitizer.(ByteDigitizer); ok {
+		return value
+	}
+	return strings.TrimSpace(value)
+}
+
+// setData replaces the data of the Entry held by the provided node with the provided data. A MutableEntry is updated in
+// place, while any other Entry is replaced by a generic Entry for the provided key.
+func setData(n Node, key string, data any) {
+	if e, ok := n.Value().(MutableEntry); ok {
+		e.SetData(data)
+		return
+	}
+	n.SetValue(NewEntry(key, data))
+}
+
+// updateSubtreeCounts adds the provided delta to the subtree count of the provided node and each of its ancestors if the
+// Trie was created using WithSubtreeCounts.
+func (t *trie) updateSubtreeCounts(n Node, delta int) {
+	if !t.subtreeCounts {
+		return
+	}
+
+	for ; n != nil; n = n.Parent() {
+		if c := subtreeCount(n); c != nil {
+			*c += delta
+		}
+	}
+}
+
+func walkPreOrder(n Node, depth int, visit func(int, Node) bool) {
+	if !visit(depth, n) {
+		return
+	}
+
+	for i := n.NextChildIndex(0); i != childNotFound; i = n.NextChildIndex(i + 1) {
+		if child, err := n.ChildAt(i); err == nil && child != nil {
+			walkPreOrder(child, depth+1, visit)
+		}
+	}
+}
+
+func walkBreadthFirst(root Node, visit func(int, Node) bool) {
+	type visited struct {
+		depth int
+		node  Node
+	}
+
+	queue := []visited{{node: root}}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		if !visit(v.depth, v.node) {
+			continue
+		}
+
+		for i := v.node.NextChildIndex(0); i != childNotFound; i = v.node.NextChildIndex(i + 1) {
+			if child, err := v.node.ChildAt(i); err == nil && child != nil {
+				queue = append(queue, visited{depth: v.depth + 1, node: child})
+			}
+		}
+	}
+}
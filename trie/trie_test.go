@@ -3,7 +3,12 @@ package trie
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"reflect"
+	"runtime"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/transientvariable/hold"
@@ -93,6 +98,70 @@ func TestTrie_AddAll(t *testing.T) {
 	assertContentEquals(t, trie, "[brown, fox, quick, the]")
 }
 
+func TestTrie_AddAllEntriesAtomic(t *testing.T) {
+	trie, err := New(WithMaxEntries(8))
+	assert.NoError(t, err)
+
+	err = trie.AddAll(&list.List[string]{"bac", "dab", "ab"})
+	assert.NoError(t, err)
+
+	assertUnchanged := func(t *testing.T) {
+		t.Helper()
+
+		assertSize(t, trie, 3)
+		assertContentEquals(t, trie, "[ab, bac, dab]")
+		assert.Equal(t, 4, trie.Height())
+
+		var reversed []string
+		iter := trie.IterateReverse()
+		for iter.HasPrevious() {
+			v, err := iter.Previous()
+			assert.NoError(t, err)
+			reversed = append(reversed, v)
+		}
+		assert.Equal(t, []string{"dab", "bac", "ab"}, reversed)
+
+		n, err := trie.CountCompletions("d")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	}
+
+	t.Run("Existing", func(t *testing.T) {
+		entries := list.List[Entry]{
+			NewEntry("dabble", nil),
+			NewEntry("dad", nil),
+			NewEntry("bac", nil),
+			NewEntry("dac", nil),
+		}
+
+		err := trie.AddAllEntries(&entries)
+		assert.ErrorContains(t, err, "index 2")
+		assertUnchanged(t)
+	})
+
+	t.Run("Batch", func(t *testing.T) {
+		entries := list.List[Entry]{
+			NewEntry("dabble", nil),
+			NewEntry("dad", nil),
+			NewEntry("dabble", nil),
+		}
+
+		err := trie.AddAllEntries(&entries)
+		assert.ErrorContains(t, err, "index 2")
+		assertUnchanged(t)
+	})
+
+	t.Run("Capacity", func(t *testing.T) {
+		err := trie.AddAll(&list.List[string]{"a", "b", "c", "d", "e", "f"})
+		assert.ErrorIs(t, err, hold.ErrCapacityReached)
+		assertUnchanged(t)
+	})
+
+	err = trie.AddAll(&list.List[string]{"dabble", "dad"})
+	assert.NoError(t, err)
+	assertContentEquals(t, trie, "[ab, bac, dab, dabble, dad]")
+}
+
 func TestTrie_Remove(t *testing.T) {
 	trie, err := New()
 	assert.NoError(t, err)
@@ -221,71 +290,2519 @@ func TestTrie_LongestCommonPrefix(t *testing.T) {
 	assertContentEquals(t, &l, "[dada, dadc]")
 }
 
-func TestTrie_ValueAt(t *testing.T) {
+func TestTrie_CompletionsIterator(t *testing.T) {
 	trie, err := New()
 	assert.NoError(t, err)
 
-	err = trie.AddAll(&list.List[string]{"Luffy", "Zoro", "Tony Chopper", "Sanji", "Frankie"})
+	_, err = trie.CompletionsIterator("da")
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	err = trie.AddAll(&list.List[string]{"acb", "d", "dabc", "daca", "da", "dad", "dadd", "db", "ab"})
 	assert.NoError(t, err)
-	assertContentEquals(t, trie, "[Frankie, Luffy, Sanji, Tony Chopper, Zoro]")
 
-	entry, err := trie.ValueAt(2)
+	_, err = trie.CompletionsIterator(" ")
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+	t.Run("Partial", func(t *testing.T) {
+		iter, err := trie.CompletionsIterator("da")
+		assert.NoError(t, err)
+
+		var values []string
+		for iter.HasNext() && len(values) < 2 {
+			v, err := iter.Next()
+			assert.NoError(t, err)
+			values = append(values, v)
+		}
+		assert.Equal(t, []string{"da", "dabc"}, values)
+		assert.True(t, iter.HasNext())
+	})
+
+	tests := []struct {
+		prefix   string
+		expected []string
+	}{
+		{prefix: "da", expected: []string{"da", "dabc", "daca", "dad", "dadd"}},
+		{prefix: "dad", expected: []string{"dad", "dadd"}},
+		{prefix: "d", expected: []string{"d", "da", "dabc", "daca", "dad", "dadd", "db"}},
+		{prefix: "a", expected: []string{"ab", "acb"}},
+		{prefix: "dac", expected: []string{"daca"}},
+		{prefix: "c", expected: nil},
+		{prefix: "z", expected: nil},
+	}
+
+	for _, tc := range tests {
+		iter, err := trie.CompletionsIterator(tc.prefix)
+		assert.NoError(t, err)
+
+		var values []string
+		for iter.HasNext() {
+			v, err := iter.Next()
+			assert.NoError(t, err)
+			values = append(values, v)
+		}
+		assert.Equal(t, tc.expected, values, "prefix: %s", tc.prefix)
+
+		_, err = iter.Next()
+		assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+	}
+}
+
+func TestTrie_CompletionsLimit(t *testing.T) {
+	trie, err := New()
 	assert.NoError(t, err)
-	assert.Equal(t, "Sanji", entry.Value())
+	assert.NoError(t, trie.Add("car", "card", "care", "cart", "cat", "cow", "dog"))
+
+	for limit := -1; limit <= 7; limit++ {
+		expected := []string{"car", "card", "care", "cart", "cat", "cow"}
+		if limit > 0 && limit < len(expected) {
+			expected = expected[:limit]
+		}
+
+		l := list.List[string]{}
+		assert.NoError(t, trie.CompletionsLimit("c", limit, &l))
+		assert.Equal(t, expected, l.Values(), limit)
+	}
+
+	l := list.List[string]{}
+	assert.NoError(t, trie.CompletionsLimit("car", 2, &l))
+	assertContentEquals(t, &l, "[car, card]")
+
+	b := list.NewBounded[string](1)
+	assert.ErrorIs(t, trie.CompletionsLimit("car", 2, b), hold.ErrCapacityReached)
+
+	empty, err := New()
+	assert.NoError(t, err)
+	assert.ErrorIs(t, empty.CompletionsLimit("c", 1, &l), hold.ErrCollectionEmpty)
 }
 
-func assertError(t *testing.T, actual error, expected error) {
-	t.Helper()
+func TestTrie_CompletionEntries(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
 
-	if expected == nil && actual != nil {
-		t.Errorf("expected error to be nil, but got '%s'", actual)
-	} else {
-		return
+	_, err = trie.CompletionEntries("a")
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	_, err = trie.LongestCommonPrefixEntries("a")
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	data := map[string]int{"acb": 1, "dadc": 2, "dada": 3, "da": 4, "ab": 5}
+	for v, d := range data {
+		assert.NoError(t, trie.AddEntry(NewEntry(v, d)))
 	}
 
-	if errors.Is(actual, expected) {
-		t.Errorf("expected error '%s', but got '%s'", expected, actual)
+	assertEntries := func(t *testing.T, entries []Entry, expected ...string) {
+		t.Helper()
+
+		values := make([]string, len(entries))
+		for i, e := range entries {
+			values[i] = e.Value()
+			assert.Equal(t, data[e.Value()], e.Data(), "value: %s", e.Value())
+		}
+		assert.Equal(t, expected, values)
 	}
+
+	entries, err := trie.CompletionEntries("a")
+	assert.NoError(t, err)
+	assertEntries(t, entries, "ab", "acb")
+
+	entries, err = trie.CompletionEntries("da")
+	assert.NoError(t, err)
+	assertEntries(t, entries, "da", "dada", "dadc")
+
+	entries, err = trie.CompletionEntries("x")
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	entries, err = trie.LongestCommonPrefixEntries("dadda")
+	assert.NoError(t, err)
+	assertEntries(t, entries, "dada", "dadc")
 }
 
-func assertContains(t *testing.T, collection hold.Collection[string], value string, expected bool) {
-	t.Helper()
+func TestTrie_TopCompletions(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
 
-	if collection.Contains(value) != expected {
-		if expected {
-			t.Errorf("expected to contain node: %s", value)
+	weight := func(e Entry) float64 {
+		return e.Data().(float64)
+	}
+
+	_, err = trie.TopCompletions("da", 2, weight)
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	weights := map[string]float64{
+		"da":    3,
+		"dab":   9,
+		"dabc":  1,
+		"daca":  5,
+		"dacb":  5,
+		"dad":   7,
+		"acb":   100,
+		"ab":    50,
+		"dazed": 5,
+	}
+	for v, w := range weights {
+		assert.NoError(t, trie.AddEntry(NewEntry(v, w)))
+	}
+
+	values := func(entries []Entry) []string {
+		v := make([]string, len(entries))
+		for i, e := range entries {
+			v[i] = e.Value()
+		}
+		return v
+	}
+
+	tests := []struct {
+		prefix   string
+		n        int
+		expected []string
+	}{
+		{prefix: "da", n: 1, expected: []string{"dab"}},
+		{prefix: "da", n: 3, expected: []string{"dab", "dad", "daca"}},
+		{prefix: "da", n: 5, expected: []string{"dab", "dad", "daca", "dacb", "dazed"}},
+		{prefix: "da", n: 100, expected: []string{"dab", "dad", "daca", "dacb", "dazed", "da", "dabc"}},
+		{prefix: "a", n: 5, expected: []string{"acb", "ab"}},
+		{prefix: "x", n: 5, expected: []string{}},
+		{prefix: "da", n: 0, expected: []string{}},
+		{prefix: "da", n: -1, expected: []string{}},
+	}
+
+	for _, tc := range tests {
+		entries, err := trie.TopCompletions(tc.prefix, tc.n, weight)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, values(entries), "prefix: %s, n: %d", tc.prefix, tc.n)
+	}
+}
+
+func TestTrie_LongestPrefixMatch(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	_, _, err = trie.LongestPrefixMatch("foobar")
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	err = trie.Add("foo", "foob", "xyz")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		query    string
+		expected string
+		found    bool
+	}{
+		{query: "foobar", expected: "foob", found: true},
+		{query: "foob", expected: "foob", found: true},
+		{query: "foo", expected: "foo", found: true},
+		{query: "fooa", expected: "foo", found: true},
+		{query: "fo", found: false},
+		{query: "bar", found: false},
+		{query: "xyzzy", expected: "xyz", found: true},
+	}
+
+	for _, tc := range tests {
+		e, found, err := trie.LongestPrefixMatch(tc.query)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.found, found, "query: %s", tc.query)
+		if tc.found {
+			assertNodeValue(t, e.Value(), tc.expected)
 		} else {
-			t.Errorf("expected not to contain node: %s", value)
+			assert.Nil(t, e)
 		}
 	}
+
+	_, _, err = trie.LongestPrefixMatch(" ")
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+	t.Run("Tokenize", func(t *testing.T) {
+		tokenize := func(trie Trie, s string) ([]string, string) {
+			var tokens []string
+			for s != "" {
+				e, found, err := trie.LongestPrefixMatch(s)
+				assert.NoError(t, err)
+				if !found {
+					break
+				}
+				tokens = append(tokens, e.Value())
+				s = s[len(e.Value()):]
+			}
+			return tokens, s
+		}
+
+		tokens, rest := tokenize(trie, "foobar")
+		assert.Equal(t, []string{"foob"}, tokens)
+		assert.Equal(t, "ar", rest)
+
+		err := trie.Add("ar")
+		assert.NoError(t, err)
+
+		tokens, rest = tokenize(trie, "foobarfoo")
+		assert.Equal(t, []string{"foob", "ar", "foo"}, tokens)
+		assert.Empty(t, rest)
+	})
 }
 
-func assertNodeValue(t *testing.T, actual any, expected string) {
-	t.Helper()
+func TestTrie_Clone(t *testing.T) {
+	trie, err := New(WithDigitizer(NewUnicodeDigitizer()), WithMaxEntries(6))
+	assert.NoError(t, err)
 
-	if n, ok := actual.(string); ok {
-		if n != expected {
-			t.Errorf("expected content of '%s', but found '%s'", expected, n)
+	payload := &struct{ count int }{count: 1}
+	assert.NoError(t, trie.AddEntry(NewEntry("dab", payload)))
+	assert.NoError(t, trie.AddAll(&list.List[string]{"bac", "dabb", "dac", "ab"}))
+
+	clone, err := trie.Clone()
+	assert.NoError(t, err)
+	assertContentEquals(t, clone, "[ab, bac, dab, dabb, dac]")
+
+	_, err = clone.Remove("dab")
+	assert.NoError(t, err)
+	_, err = clone.Remove("ab")
+	assert.NoError(t, err)
+	assert.NoError(t, clone.Add("ça", "dad"))
+
+	assertContentEquals(t, clone, "[bac, dabb, dac, dad, ça]")
+	assertContentEquals(t, trie, "[ab, bac, dab, dabb, dac]")
+	assertSize(t, trie, 5)
+
+	var reversed []string
+	iter := trie.IterateReverse()
+	for iter.HasPrevious() {
+		v, err := iter.Previous()
+		assert.NoError(t, err)
+		reversed = append(reversed, v)
+	}
+	assert.Equal(t, []string{"dac", "dabb", "dab", "bac", "ab"}, reversed)
+
+	e, err := trie.Entry("dab")
+	assert.NoError(t, err)
+	assert.Same(t, payload, e.Data())
+
+	assert.ErrorIs(t, clone.Add("x", "y"), hold.ErrCapacityReached)
+
+	empty, err := New()
+	assert.NoError(t, err)
+
+	clone, err = empty.Clone()
+	assert.NoError(t, err)
+	assert.True(t, clone.IsEmpty())
+}
+
+func TestTrie_Merge(t *testing.T) {
+	newTrie := func(t *testing.T, entries map[string]any) Trie {
+		t.Helper()
+
+		trie, err := New()
+		assert.NoError(t, err)
+		for v, d := range entries {
+			assert.NoError(t, trie.AddEntry(NewEntry(v, d)))
 		}
-	} else {
-		t.Errorf("expected type of 'string', but found '%v'", reflect.TypeOf(actual))
+		return trie
 	}
+
+	t.Run("Disjoint", func(t *testing.T) {
+		trie := newTrie(t, map[string]any{"ab": 1, "dab": 2})
+		other := newTrie(t, map[string]any{"bac": 3, "dac": 4, "da": 5})
+
+		assert.NoError(t, trie.Merge(other))
+		assertContentEquals(t, trie, "[ab, bac, da, dab, dac]")
+		assertContentEquals(t, other, "[bac, da, dac]")
+
+		e, err := trie.Entry("dac")
+		assert.NoError(t, err)
+		assert.Equal(t, 4, e.Data())
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		trie := newTrie(t, map[string]any{"ab": 1, "dab": 2})
+		other := newTrie(t, map[string]any{"bac": 3, "dab": 4})
+
+		err := trie.Merge(other)
+		assert.Error(t, err)
+		assertContentEquals(t, trie, "[ab, dab]")
+
+		e, err := trie.Entry("dab")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, e.Data())
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		trie := newTrie(t, map[string]any{"ab": 1, "dab": 2})
+		other := newTrie(t, map[string]any{"bac": 3, "dab": 4})
+
+		assert.NoError(t, trie.MergeOverwrite(other))
+		assertContentEquals(t, trie, "[ab, bac, dab]")
+
+		e, err := trie.Entry("dab")
+		assert.NoError(t, err)
+		assert.Equal(t, 4, e.Data())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		trie := newTrie(t, nil)
+		other := newTrie(t, map[string]any{"bac": 3, "dab": 4})
+
+		assert.NoError(t, trie.Merge(other))
+		assertContentEquals(t, trie, "[bac, dab]")
+
+		assert.NoError(t, trie.Merge(newTrie(t, nil)))
+		assert.NoError(t, trie.Merge(nil))
+		assertContentEquals(t, trie, "[bac, dab]")
+	})
+
+	t.Run("Capacity", func(t *testing.T) {
+		trie, err := New(WithMaxEntries(3))
+		assert.NoError(t, err)
+		assert.NoError(t, trie.Add("ab", "dab"))
+
+		err = trie.Merge(newTrie(t, map[string]any{"bac": 3, "dac": 4}))
+		assert.ErrorIs(t, err, hold.ErrCapacityReached)
+		assertContentEquals(t, trie, "[ab, dab]")
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		d, err := NewAlphabetDigitizer("aelpz", true)
+		assert.NoError(t, err)
+
+		trie, err := New(WithDigitizer(d))
+		assert.NoError(t, err)
+		assert.NoError(t, trie.AddEntry(NewEntry("apple", 1)))
+
+		// The unsupported entry is ordered after entries that would otherwise be inserted or replaced.
+		other := newTrie(t, map[string]any{"aa": 2, "apple": 3, "zz~": 4})
+		err = trie.MergeOverwrite(other)
+		assert.ErrorIs(t, err, ErrUnsupportedCharacter)
+		assertContentEquals(t, trie, "[apple]")
+
+		e, err := trie.Entry("apple")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, e.Data())
+
+		// Entries from a Trie with a different Digitizer are merged if the Digitizer for the Trie supports them.
+		other, err = New(WithDigitizer(NewUnicodeDigitizer()))
+		assert.NoError(t, err)
+		assert.NoError(t, other.Add("pea", "zap"))
+		assert.NoError(t, trie.Merge(other))
+		assertContentEquals(t, trie, "[apple, pea, zap]")
+	})
+
+	t.Run("Sync", func(t *testing.T) {
+		trie := newTrie(t, map[string]any{"ab": 1})
+		assert.NoError(t, trie.Merge(Synchronized(newTrie(t, map[string]any{"bac": 2}))))
+		assertContentEquals(t, trie, "[ab, bac]")
+	})
 }
 
-func assertSize(t *testing.T, collection hold.Collection[string], expected int) {
-	t.Helper()
+func TestTrie_ValueAt(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
 
-	actual := collection.Len()
-	if actual != expected {
-		t.Errorf("expected size of '%d', but found '%d'", expected, actual)
+	err = trie.AddAll(&list.List[string]{"Luffy", "Zoro", "Tony Chopper", "Sanji", "Frankie"})
+	assert.NoError(t, err)
+	assertContentEquals(t, trie, "[Frankie, Luffy, Sanji, Tony Chopper, Zoro]")
+
+	entry, err := trie.ValueAt(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "Sanji", entry.Value())
+}
+
+func TestTrie_Height(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, trie.Height())
+
+	_, err = trie.DepthOf("dab")
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	err = trie.AddAll(&list.List[string]{"bac", "dab", "dabble", "dac", "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, trie.Height())
+
+	depths := map[string]int{"a": 2, "bac": 4, "dab": 4, "dabble": 7, "dac": 4}
+	for v, expected := range depths {
+		d, err := trie.DepthOf(v)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, d, "value: %s", v)
 	}
+
+	for _, v := range []string{"da", "dabb", "dabbles", "x"} {
+		_, err = trie.DepthOf(v)
+		assert.ErrorIs(t, err, hold.ErrNotFound, "value: %s", v)
+	}
+
+	_, err = trie.DepthOf(" ")
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+	_, err = trie.Remove("dabble")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, trie.Height())
 }
 
-func assertContentEquals(t *testing.T, collection hold.Collection[string], expected string) {
-	t.Helper()
+func TestTrie_CountCompletions(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
 
-	actual := fmt.Sprintf("%s", collection)
-	if actual != expected {
-		t.Errorf("expected content of '%s', but found '%s'", expected, actual)
+	_, err = trie.CountCompletions("a")
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	err = trie.AddAll(&list.List[string]{"acb", "dabc", "daca", "da", "ab"})
+	assert.NoError(t, err)
+
+	for prefix, expected := range map[string]int{"a": 2, "da": 3, "dab": 1, "dabc": 1, "x": 0, "dax": 0, "dabcd": 0} {
+		n, err := trie.CountCompletions(prefix)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, n, "prefix: %s", prefix)
+
+		l := list.List[string]{}
+		err = trie.Completions(prefix, &l)
+		assert.NoError(t, err)
+		assert.Equal(t, l.Len(), n, "prefix: %s", prefix)
+	}
+}
+
+func TestTrie_MaxEntries(t *testing.T) {
+	trie, err := New(WithMaxEntries(3))
+	assert.NoError(t, err)
+
+	err = trie.Add("dab", "ab", "dac")
+	assert.NoError(t, err)
+
+	err = trie.Add("bac")
+	assert.ErrorIs(t, err, hold.ErrCapacityReached)
+	assertSize(t, trie, 3)
+	assertContains(t, trie, "bac", false)
+	assertContentEquals(t, trie, "[ab, dab, dac]")
+
+	var reversed []string
+	iter := trie.IterateReverse()
+	for iter.HasPrevious() {
+		v, err := iter.Previous()
+		assert.NoError(t, err)
+		reversed = append(reversed, v)
+	}
+	assert.Equal(t, []string{"dac", "dab", "ab"}, reversed)
+
+	_, err = trie.Remove("dab")
+	assert.NoError(t, err)
+
+	err = trie.Add("bac")
+	assert.NoError(t, err)
+	assertContentEquals(t, trie, "[ab, bac, dac]")
+
+	t.Run("NewFromSorted", func(t *testing.T) {
+		entries := []Entry{&entry{value: "ab"}, &entry{value: "bac"}, &entry{value: "dab"}}
+
+		_, err := NewFromSorted(entries, WithMaxEntries(2))
+		assert.ErrorIs(t, err, hold.ErrCapacityReached)
+
+		trie, err := NewFromSorted(entries, WithMaxEntries(3))
+		assert.NoError(t, err)
+		assertSize(t, trie, 3)
+	})
+
+	t.Run("Unbounded", func(t *testing.T) {
+		trie, err := New(WithMaxEntries(0))
+		assert.NoError(t, err)
+		assert.NoError(t, trie.AddAll(&list.List[string]{"ab", "bac", "dab", "dac"}))
+		assertSize(t, trie, 4)
+
+		_, err = New(WithMaxEntries(-1))
+		assert.Error(t, err)
+	})
+}
+
+func TestTrie_ForEach(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	err = trie.AddAll(&list.List[string]{"bac", "dab", "dabb", "dac", "ab"})
+	assert.NoError(t, err)
+
+	var visited []string
+	err = trie.ForEach(func(v string) error {
+		visited = append(visited, v)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ab", "bac", "dab", "dabb", "dac"}, visited)
+
+	errStop := errors.New("stop")
+	visited = nil
+	err = trie.ForEach(func(v string) error {
+		visited = append(visited, v)
+		if v == "dab" {
+			return errStop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, []string{"ab", "bac", "dab"}, visited)
+}
+
+func TestTrie_IterateReset(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	err = trie.AddAll(&list.List[string]{"bac", "dab", "dabb", "dac", "ab"})
+	assert.NoError(t, err)
+
+	iter, ok := trie.Iterate().(hold.ResettableIterator[string])
+	assert.True(t, ok)
+
+	var first []string
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		first = append(first, v)
+	}
+
+	_, err = iter.Next()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	iter.Reset()
+
+	var second []string
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		second = append(second, v)
+	}
+	assert.Equal(t, []string{"ab", "bac", "dab", "dabb", "dac"}, first)
+	assert.Equal(t, first, second)
+}
+
+func TestTrie_IterateRemove(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	words := []string{"bat", "bath", "bear", "bee", "bell", "cat", "cow"}
+	assert.NoError(t, trie.AddAll(&list.List[string]{"bee", "cat", "bath", "cow", "bat", "bell", "bear"}))
+
+	iter, ok := trie.Iterate().(hold.MutableIterator[string])
+	assert.True(t, ok)
+	assert.ErrorIs(t, iter.Remove(), hold.ErrNotFound)
+
+	var visited []string
+	for n := 0; iter.HasNext(); n++ {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		visited = append(visited, v)
+
+		if n%2 == 0 {
+			assert.NoError(t, iter.Remove())
+			assert.ErrorIs(t, iter.Remove(), hold.ErrNotFound)
+		}
+	}
+	assert.Equal(t, words, visited)
+	assertSize(t, trie, 3)
+	assertContentEquals(t, trie, "[bath, bee, cat]")
+	assertContains(t, trie, "bat", false)
+	assertContains(t, trie, "bath", true)
+
+	reverse := trie.IterateReverse()
+	assert.True(t, reverse.HasPrevious())
+	v, err := reverse.Previous()
+	assert.NoError(t, err)
+	assert.Equal(t, "cat", v)
+	assert.NoError(t, reverse.(hold.MutableIterator[string]).Remove())
+
+	v, err = reverse.Previous()
+	assert.NoError(t, err)
+	assert.Equal(t, "bee", v)
+	assertContentEquals(t, trie, "[bath, bee]")
+
+	completions, err := trie.CompletionsIterator("ba")
+	assert.NoError(t, err)
+	v, err = completions.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "bath", v)
+	assert.NoError(t, completions.(hold.MutableIterator[string]).Remove())
+	assert.False(t, completions.HasNext())
+	assertContentEquals(t, trie, "[bee]")
+}
+
+func TestTrie_IteratePeek(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	iter, ok := trie.Iterate().(hold.PeekableIterator[string])
+	assert.True(t, ok)
+
+	_, err = iter.Peek()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	err = trie.AddAll(&list.List[string]{"bac", "dab", "dac", "ab"})
+	assert.NoError(t, err)
+
+	for _, expected := range []string{"ab", "bac", "dab", "dac"} {
+		v, err := iter.Peek()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, expected)
+
+		v, err = iter.Peek()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, expected)
+
+		v, err = iter.Next()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, expected)
+	}
+
+	_, err = iter.Peek()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	t.Run("Removed", func(t *testing.T) {
+		iter, ok := trie.Iterate().(hold.PeekableIterator[string])
+		assert.True(t, ok)
+
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "ab")
+
+		_, err = trie.Remove("bac")
+		assert.NoError(t, err)
+
+		v, err = iter.Peek()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "dab")
+
+		v, err = iter.Next()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "dab")
+	})
+}
+
+func TestTrie_IterateReverse(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	iter := trie.IterateReverse()
+	assert.False(t, iter.HasPrevious())
+	_, err = iter.Previous()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	err = trie.AddAll(&list.List[string]{"bac", "dab", "dabb", "dac", "ab"})
+	assert.NoError(t, err)
+
+	var values []string
+	iter = trie.IterateReverse()
+	for iter.HasPrevious() {
+		v, err := iter.Previous()
+		assert.NoError(t, err)
+		values = append(values, v)
+	}
+	assert.Equal(t, []string{"dac", "dabb", "dab", "bac", "ab"}, values)
+
+	_, err = iter.Previous()
+	assert.ErrorIs(t, err, hold.ErrNoMoreElements)
+
+	v, err := iter.Next()
+	assert.NoError(t, err)
+	assertNodeValue(t, v, "ab")
+}
+
+func TestTrie_IterateFrom(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	err = trie.AddAll(&list.List[string]{"bac", "dab", "dabb", "dac", "ab"})
+	assert.NoError(t, err)
+
+	_, err = trie.IterateFrom(" ")
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+	collect := func(iter hold.Iterator[string]) []string {
+		var values []string
+		for iter.HasNext() {
+			v, err := iter.Next()
+			assert.NoError(t, err)
+			values = append(values, v)
+		}
+		return values
+	}
+
+	t.Run("Cursor", func(t *testing.T) {
+		iter, err := trie.IterateFrom("dab")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"dab", "dabb", "dac"}, collect(iter))
+
+		iter, err = trie.IterateFrom("daba")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"dabb", "dac"}, collect(iter))
+
+		iter, err = trie.IterateFrom("c")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"dab", "dabb", "dac"}, collect(iter))
+
+		iter, err = trie.IterateFrom("a")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ab", "bac", "dab", "dabb", "dac"}, collect(iter))
+
+		iter, err = trie.IterateFrom("dad")
+		assert.NoError(t, err)
+		assert.False(t, iter.HasNext())
+
+		v, err := iter.Previous()
+		assert.NoError(t, err)
+		assert.Equal(t, "dac", v)
+	})
+
+	t.Run("Alternating", func(t *testing.T) {
+		iter, err := trie.IterateFrom("dab")
+		assert.NoError(t, err)
+
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "dab")
+
+		v, err = iter.Previous()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "dab")
+
+		v, err = iter.Previous()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "bac")
+
+		v, err = iter.Next()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "bac")
+
+		v, err = iter.Next()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "dab")
+	})
+
+	t.Run("SkipRemoved", func(t *testing.T) {
+		iter, err := trie.IterateFrom("dab")
+		assert.NoError(t, err)
+
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "dab")
+
+		_, err = trie.Remove("dab")
+		assert.NoError(t, err)
+		_, err = trie.Remove("dabb")
+		assert.NoError(t, err)
+
+		v, err = iter.Next()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "dac")
+
+		v, err = iter.Previous()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "dac")
+
+		_, err = trie.Remove("bac")
+		assert.NoError(t, err)
+
+		v, err = iter.Previous()
+		assert.NoError(t, err)
+		assertNodeValue(t, v, "ab")
+		assert.False(t, iter.HasPrevious())
+	})
+}
+
+func TestTrie_Range(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	l := list.List[string]{}
+	err = trie.Range("a", "b", &l)
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	err = trie.AddAll(&list.List[string]{"bac", "dab", "dabb", "dac", "daca", "dad", "dadb", "ab"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		low      string
+		high     string
+		options  []func(*RangeOption)
+		expected string
+	}{
+		{low: "dab", high: "dad", expected: "[dab, dabb, dac, daca, dad]"},
+		{low: "dab", high: "dad", options: []func(*RangeOption){ExcludeLow()}, expected: "[dabb, dac, daca, dad]"},
+		{low: "dab", high: "dad", options: []func(*RangeOption){ExcludeHigh()}, expected: "[dab, dabb, dac, daca]"},
+		{low: "dab", high: "dad", options: []func(*RangeOption){ExcludeLow(), ExcludeHigh()}, expected: "[dabb, dac, daca]"},
+		{low: "c", high: "daca", expected: "[dab, dabb, dac, daca]"},
+		{low: "a", high: "z", expected: "[ab, bac, dab, dabb, dac, daca, dad, dadb]"},
+		{low: "dab", high: "dab", expected: "[dab]"},
+		{low: "dab", high: "dab", options: []func(*RangeOption){ExcludeHigh()}, expected: "[]"},
+		{low: "bb", high: "c", expected: "[]"},
+		{low: "e", high: "z", expected: "[]"},
+	}
+
+	for _, tc := range tests {
+		l.Clear()
+		err := trie.Range(tc.low, tc.high, &l, tc.options...)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, tc.expected)
+	}
+
+	err = trie.Range("dad", "dab", &l)
+	assert.Error(t, err)
+
+	err = trie.Range("", "dab", &l)
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+}
+
+func TestNewFromSorted(t *testing.T) {
+	values := []string{"ab", "bac", "dab", "dabb", "dabba", "dac", "daca"}
+
+	entries := make([]Entry, len(values))
+	for i, v := range values {
+		entries[i] = NewEntry(v, i)
+	}
+
+	trie, err := NewFromSorted(entries)
+	assert.NoError(t, err)
+	assert.Equal(t, len(values), trie.Len())
+	assertContentEquals(t, trie, "[ab, bac, dab, dabb, dabba, dac, daca]")
+
+	for i, v := range values {
+		e, err := trie.Entry(v)
+		assert.NoError(t, err)
+		assert.Equal(t, i, e.Data())
+	}
+
+	p, err := trie.Predecessor("dabba")
+	assert.NoError(t, err)
+	assertNodeValue(t, p, "dabb")
+
+	err = trie.Add("daa")
+	assert.NoError(t, err)
+	assertContentEquals(t, trie, "[ab, bac, daa, dab, dabb, dabba, dac, daca]")
+
+	r, err := trie.Remove("dabb")
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assertContentEquals(t, trie, "[ab, bac, daa, dab, dabba, dac, daca]")
+
+	t.Run("Unsorted", func(t *testing.T) {
+		_, err := NewFromSorted([]Entry{NewEntry("dab", nil), NewEntry("dac", nil), NewEntry("dabb", nil)})
+		assert.ErrorContains(t, err, "not sorted")
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		_, err := NewFromSorted([]Entry{NewEntry("dab", nil), NewEntry("dab", nil)})
+		assert.ErrorContains(t, err, "prefix-free")
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		trie, err := NewFromSorted(nil)
+		assert.NoError(t, err)
+		assert.True(t, trie.IsEmpty())
+
+		err = trie.Add("dab")
+		assert.NoError(t, err)
+		assertContentEquals(t, trie, "[dab]")
+	})
+}
+
+func TestTrie_FuzzyMatch(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	l := list.List[string]{}
+	err = trie.FuzzyMatch("cat", 1, &l)
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	err = trie.AddAll(&list.List[string]{"cat", "cart", "cast", "chat", "coat", "cut", "dog", "act", "at", "scatter"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		query       string
+		maxDistance int
+		expected    string
+	}{
+		{query: "cat", maxDistance: 0, expected: "[cat]"},
+		{query: "cat", maxDistance: 1, expected: "[at, cart, cast, cat, chat, coat, cut]"},
+		{query: "cat", maxDistance: 2, expected: "[act, at, cart, cast, cat, chat, coat, cut]"},
+		{query: "dgo", maxDistance: 1, expected: "[]"},
+		{query: "dgo", maxDistance: 2, expected: "[dog]"},
+		{query: "xyz", maxDistance: 2, expected: "[]"},
+	}
+
+	for _, tc := range tests {
+		l.Clear()
+		err := trie.FuzzyMatch(tc.query, tc.maxDistance, &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, tc.expected)
+
+		expected := []string{}
+		for _, v := range trie.Values() {
+			if levenshtein(tc.query, v) <= tc.maxDistance {
+				expected = append(expected, v)
+			}
+		}
+		assert.Equal(t, expected, l.Values(), "query: %s", tc.query)
+	}
+
+	err = trie.FuzzyMatch("cat", -1, &l)
+	assert.Error(t, err)
+
+	err = trie.FuzzyMatch(" ", 1, &l)
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+	t.Run("Unicode", func(t *testing.T) {
+		trie, err := New(WithDigitizer(NewUnicodeDigitizer()))
+		assert.NoError(t, err)
+
+		err = trie.AddAll(&list.List[string]{"café", "cafe", "naïve", "naive", "日本語"})
+		assert.NoError(t, err)
+
+		l := list.List[string]{}
+		err = trie.FuzzyMatch("cafè", 1, &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, "[cafe, café]")
+
+		l.Clear()
+		err = trie.FuzzyMatch("日本", 1, &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, "[日本語]")
+	})
+}
+
+func TestTrie_WildcardMatch(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	l := list.List[string]{}
+	err = trie.WildcardMatch("c?t", '?', &l)
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	err = trie.AddAll(&list.List[string]{"cat", "cot", "cut", "coat", "cats", "dog", "dot", "ca"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{pattern: "c?t", expected: "[cat, cot, cut]"},
+		{pattern: "?o?", expected: "[cot, dog, dot]"},
+		{pattern: "ca?", expected: "[cat]"},
+		{pattern: "???", expected: "[cat, cot, cut, dog, dot]"},
+		{pattern: "??", expected: "[ca]"},
+		{pattern: "c??t", expected: "[coat]"},
+		{pattern: "cat", expected: "[cat]"},
+		{pattern: "c?ts", expected: "[cats]"},
+		{pattern: "?????????", expected: "[]"},
+		{pattern: "x?", expected: "[]"},
+	}
+
+	for _, tc := range tests {
+		l.Clear()
+		err := trie.WildcardMatch(tc.pattern, '?', &l)
+		assert.NoError(t, err)
+		assertContentEquals(t, &l, tc.expected)
+	}
+
+	l.Clear()
+	err = trie.WildcardMatch("d*g", '*', &l)
+	assert.NoError(t, err)
+	assertContentEquals(t, &l, "[dog]")
+
+	err = trie.WildcardMatch("", '?', &l)
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+}
+
+func TestTrie_ValuesCorrupted(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, trie.Add("dab", "cat", "xyz"))
+
+	n, err := nodeOf(trie, "dab")
+	assert.NoError(t, err)
+	n.(*leaf).markDeleted()
+
+	entries, err := trie.Entries()
+	assert.ErrorIs(t, err, hold.ErrNotFound)
+	assert.Len(t, entries, 1)
+
+	assert.NotPanics(t, func() {
+		assert.Equal(t, []string{"cat", "xyz"}, trie.Values())
+	})
+}
+
+func TestTrie_Classify(t *testing.T) {
+	digitizers := map[string]Digitizer{
+		"PrefixFree":    NewASCIIDigitizer(),
+		"NonPrefixFree": NewNonPrefixFreeASCIIDigitizer(),
+	}
+
+	for name, d := range digitizers {
+		t.Run(name, func(t *testing.T) {
+			trie, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+
+			r, err := trie.Classify("dab")
+			assert.NoError(t, err)
+			assert.Equal(t, Unmatched, r)
+
+			assert.NoError(t, trie.Add("dab", "dabble", "xyz"))
+
+			tests := []struct {
+				value    string
+				expected SearchResult
+			}{
+				{value: "dab", expected: Matched},
+				{value: "dabble", expected: Matched},
+				{value: "d", expected: Prefix},
+				{value: "da", expected: Prefix},
+				{value: "dabb", expected: Prefix},
+				{value: "dabs", expected: Extension},
+				{value: "dabbles", expected: Extension},
+				{value: "xyzzy", expected: Extension},
+				{value: "db", expected: Unmatched},
+				{value: "abc", expected: Unmatched},
+			}
+
+			for _, tc := range tests {
+				r, err := trie.Classify(tc.value)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, r, "value: %s, result: %s", tc.value, r)
+			}
+
+			_, err = trie.Classify(" ")
+			assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+			_, err = trie.Classify("da\x01")
+			assert.Error(t, err)
+		})
+	}
+
+	assert.Equal(t, "Prefix", Prefix.String())
+	assert.Equal(t, "SearchResult(0)", SearchResult(0).String())
+}
+
+func TestTrie_ContainsAll(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+	assert.False(t, trie.ContainsAll("dab"))
+	assert.False(t, trie.ContainsAny("dab"))
+
+	assert.NoError(t, trie.Add("dab", "dabble", "xyz"))
+
+	tests := []struct {
+		name   string
+		values []string
+		all    bool
+		any    bool
+	}{
+		{name: "AllPresent", values: []string{"dab", "xyz", "dabble"}, all: true, any: true},
+		{name: "SomePresent", values: []string{"dab", "da", "xyz"}, all: false, any: true},
+		{name: "NonePresent", values: []string{"da", "dabbles", " "}, all: false, any: false},
+		{name: "NoValues", values: nil, all: true, any: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.all, trie.ContainsAll(tc.values...))
+			assert.Equal(t, tc.any, trie.ContainsAny(tc.values...))
+		})
+	}
+}
+
+func TestTrie_HasPrefix(t *testing.T) {
+	digitizers := map[string]Digitizer{
+		"PrefixFree":    NewASCIIDigitizer(),
+		"NonPrefixFree": NewNonPrefixFreeASCIIDigitizer(),
+	}
+
+	for name, d := range digitizers {
+		t.Run(name, func(t *testing.T) {
+			trie, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+			assert.False(t, trie.HasPrefix("da"))
+
+			assert.NoError(t, trie.Add("dab", "xyz"))
+			assert.False(t, trie.Contains("da"))
+			assert.True(t, trie.HasPrefix("da"))
+			assert.True(t, trie.HasPrefix("d"))
+			assert.True(t, trie.HasPrefix("dab"))
+			assert.True(t, trie.HasPrefix(" x "))
+			assert.False(t, trie.HasPrefix("dabc"))
+			assert.False(t, trie.HasPrefix("db"))
+			assert.False(t, trie.HasPrefix("a"))
+			assert.False(t, trie.HasPrefix(""))
+			assert.False(t, trie.HasPrefix("  "))
+		})
+	}
+}
+
+func TestTrie_RemovePrefix(t *testing.T) {
+	digitizers := map[string]Digitizer{
+		"PrefixFree":    NewASCIIDigitizer(),
+		"NonPrefixFree": NewNonPrefixFreeASCIIDigitizer(),
+	}
+
+	for name, d := range digitizers {
+		t.Run(name, func(t *testing.T) {
+			trie, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+			assert.NoError(t, trie.AddAll(&list.List[string]{"dabc", "xyz", "da", "dab"}))
+
+			n, err := trie.RemovePrefix("dx")
+			assert.NoError(t, err)
+			assert.Zero(t, n)
+			assertSize(t, trie, 4)
+
+			n, err = trie.RemovePrefix("da")
+			assert.NoError(t, err)
+			assert.Equal(t, 3, n)
+			assertSize(t, trie, 1)
+			assertContentEquals(t, trie, "[xyz]")
+			assertContains(t, trie, "da", false)
+			assertContains(t, trie, "dab", false)
+
+			x, err := d.DigitOf("x", 0)
+			assert.NoError(t, err)
+
+			root := rootOf(trie)
+			assert.Equal(t, x, root.NextChildIndex(0))
+			assert.Equal(t, childNotFound, root.NextChildIndex(x+1))
+
+			n, err = trie.RemovePrefix("da")
+			assert.NoError(t, err)
+			assert.Zero(t, n)
+
+			assert.NoError(t, trie.Add("dab"))
+			assertContentEquals(t, trie, "[dab, xyz]")
+
+			n, err = trie.RemovePrefix("xyz")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+			assertContentEquals(t, trie, "[dab]")
+
+			_, err = trie.RemovePrefix(" ")
+			assert.ErrorIs(t, err, hold.ErrValueRequired)
+		})
+	}
+
+	trie, err := New()
+	assert.NoError(t, err)
+
+	_, err = trie.RemovePrefix("da")
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+}
+
+func TestTrie_TerminalNodes(t *testing.T) {
+	trie, err := New(WithDigitizer(NewNonPrefixFreeASCIIDigitizer()))
+	assert.NoError(t, err)
+
+	for _, v := range []string{"cards", "car", "card"} {
+		assert.NoError(t, trie.AddEntry(NewEntry(v, len(v))))
+	}
+	assertContentEquals(t, trie, "[car, card, cards]")
+
+	for _, v := range []string{"car", "card", "cards"} {
+		e, err := trie.Entry(v)
+		assert.NoError(t, err)
+		assert.Equal(t, v, e.Value())
+		assert.Equal(t, len(v), e.Data())
+	}
+
+	r, err := trie.Remove("car")
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assertSize(t, trie, 2)
+	assertContains(t, trie, "car", false)
+	assertContains(t, trie, "card", true)
+	assertContentEquals(t, trie, "[card, cards]")
+
+	_, err = trie.Entry("car")
+	assert.ErrorIs(t, err, hold.ErrNotFound)
+
+	r, err = trie.Remove("car")
+	assert.NoError(t, err)
+	assert.False(t, r)
+
+	l := list.List[string]{}
+	assert.NoError(t, trie.Completions("car", &l))
+	assertContentEquals(t, &l, "[card, cards]")
+
+	assert.NoError(t, trie.Add("car"))
+	assertContentEquals(t, trie, "[car, card, cards]")
+
+	r, err = trie.Remove("card")
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assertContains(t, trie, "car", true)
+	assertContains(t, trie, "card", false)
+	assertContains(t, trie, "cards", true)
+	assertContentEquals(t, trie, "[car, cards]")
+
+	r, err = trie.Remove("cards")
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assertContains(t, trie, "car", true)
+	assertContentEquals(t, trie, "[car]")
+
+	p, err := trie.Predecessor("cards")
+	assert.NoError(t, err)
+	assert.Equal(t, "car", p)
+}
+
+func TestTrie_CompletionsOrder(t *testing.T) {
+	digitizers := map[string]Digitizer{
+		"PrefixFree":    NewASCIIDigitizer(),
+		"NonPrefixFree": NewNonPrefixFreeASCIIDigitizer(),
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	values := make([]string, 0, 500)
+	for range cap(values) {
+		b := make([]byte, 1+r.IntN(6))
+		for i := range b {
+			b[i] = "abAB0 ~"[r.IntN(7)]
+		}
+		values = append(values, string(b))
+	}
+
+	for name, d := range digitizers {
+		t.Run(name, func(t *testing.T) {
+			trie, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+
+			var expected []string
+			for _, v := range values {
+				if v = strings.TrimSpace(v); v != "" && !trie.Contains(v) {
+					assert.NoError(t, trie.Add(v))
+					expected = append(expected, v)
+				}
+			}
+			slices.Sort(expected)
+
+			entries, err := trie.Entries()
+			assert.NoError(t, err)
+			actual := make([]string, 0, len(entries))
+			for _, e := range entries {
+				actual = append(actual, e.Value())
+			}
+			assert.Equal(t, expected, actual)
+
+			for _, prefix := range []string{"a", "ab", "B", "0", "~a", "b a"} {
+				var matches []string
+				for _, v := range expected {
+					if strings.HasPrefix(v, prefix) {
+						matches = append(matches, v)
+					}
+				}
+
+				ascending := list.List[string]{}
+				assert.NoError(t, trie.Completions(prefix, &ascending))
+				assert.Equal(t, matches, ascending.Values())
+
+				descending := list.List[string]{}
+				assert.NoError(t, trie.DescendingCompletions(prefix, &descending))
+				slices.Reverse(matches)
+				assert.Equal(t, matches, descending.Values())
+			}
+		})
+	}
+
+	trie, err := New()
+	assert.NoError(t, err)
+	assert.ErrorIs(t, trie.DescendingCompletions("a", &list.List[string]{}), hold.ErrCollectionEmpty)
+
+	assert.NoError(t, trie.Add("car", "card", "cart", "cat", "dog"))
+	l := list.List[string]{}
+	assert.NoError(t, trie.DescendingCompletions("car", &l))
+	assertContentEquals(t, &l, "[cart, card, car]")
+
+	l = list.List[string]{}
+	assert.NoError(t, trie.DescendingCompletions("cow", &l))
+	assertContentEquals(t, &l, "[]")
+}
+
+func TestTrie_Nearest(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	_, err = trie.Nearest("car")
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	for _, v := range []string{"car", "cow", "dog", "zebra"} {
+		assert.NoError(t, trie.AddEntry(NewEntry(v, len(v))))
+	}
+
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "dog", expected: "dog"},
+		{value: "apple", expected: "car"},
+		{value: "c", expected: "car"},
+		{value: "zz", expected: "zebra"},
+		{value: "cat", expected: "car"},
+		{value: "cars", expected: "car"},
+		{value: "cp", expected: "cow"},
+		{value: "e", expected: "dog"},
+		{value: "y", expected: "zebra"},
+	}
+
+	for _, tc := range tests {
+		e, err := trie.Nearest(tc.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, e.Value(), tc.value)
+		assert.Equal(t, len(tc.expected), e.Data())
+	}
+
+	_, err = trie.Nearest(" ")
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+}
+
+func TestTrie_PathString(t *testing.T) {
+	digitizers := map[string]Digitizer{
+		"PrefixFree":    NewASCIIDigitizer(),
+		"NonPrefixFree": NewNonPrefixFreeASCIIDigitizer(),
+	}
+
+	for name, d := range digitizers {
+		t.Run(name, func(t *testing.T) {
+			trie, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+
+			values := []string{"car", "card", "cat", "Dog!", "d o g", "~"}
+			assert.NoError(t, trie.Add(values...))
+
+			var paths []string
+			err = trie.Walk(PreOrder, func(depth int, n Node) bool {
+				p, err := trie.PathString(n)
+				assert.NoError(t, err)
+				if n.IsLeaf() {
+					assert.Equal(t, n.Value().Value(), p)
+					paths = append(paths, p)
+				} else if depth > 0 {
+					assert.True(t, strings.HasPrefix(terminalValue(n), p), p)
+				}
+				return true
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, trie.Values(), paths)
+
+			n, err := nodeOf(trie, "card")
+			assert.NoError(t, err)
+
+			p, err := trie.PathString(n.Parent())
+			assert.NoError(t, err)
+			if d.IsPrefixFree() {
+				assert.Equal(t, "card", p)
+			} else {
+				assert.Equal(t, "car", p)
+			}
+
+			p, err = trie.PathString(rootOf(trie))
+			assert.NoError(t, err)
+			assert.Equal(t, "", p)
+
+			_, err = trie.PathString(nil)
+			assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+			_, err = trie.PathString(newLeaf(0, hybridLayout))
+			assert.ErrorIs(t, err, hold.ErrNotFound)
+		})
+	}
+}
+
+func TestTrie_Put(t *testing.T) {
+	trie, err := New(WithMaxEntries(2))
+	assert.NoError(t, err)
+
+	_, ok := trie.Get("luffy")
+	assert.False(t, ok)
+	assert.ErrorIs(t, trie.Update("luffy", 1), hold.ErrCollectionEmpty)
+
+	assert.NoError(t, trie.Put("luffy", 1))
+	assert.NoError(t, trie.Put("zoro", 2))
+
+	d, ok := trie.Get("luffy")
+	assert.True(t, ok)
+	assert.Equal(t, 1, d)
+
+	assert.NoError(t, trie.Put("luffy", "straw hat"))
+	assertSize(t, trie, 2)
+
+	d, ok = trie.Get("luffy")
+	assert.True(t, ok)
+	assert.Equal(t, "straw hat", d)
+
+	e, err := trie.Entry("luffy")
+	assert.NoError(t, err)
+	assert.Equal(t, "straw hat", e.Data())
+
+	assert.NoError(t, trie.Update("zoro", "three swords"))
+	d, ok = trie.Get("zoro")
+	assert.True(t, ok)
+	assert.Equal(t, "three swords", d)
+
+	assert.ErrorIs(t, trie.Update("nami", 3), hold.ErrNotFound)
+	assert.ErrorIs(t, trie.Update(" ", 3), hold.ErrValueRequired)
+	assert.ErrorIs(t, trie.Put(" ", 3), hold.ErrValueRequired)
+	assert.ErrorIs(t, trie.Put("nami", 3), hold.ErrCapacityReached)
+	assertContains(t, trie, "nami", false)
+
+	_, ok = trie.Get("lu")
+	assert.False(t, ok)
+	assertContentEquals(t, trie, "[luffy, zoro]")
+
+	t.Run("Unsupported", func(t *testing.T) {
+		dna, err := NewAlphabetDigitizer("ACGT", true)
+		assert.NoError(t, err)
+
+		trie, err := New(WithDigitizer(dna))
+		assert.NoError(t, err)
+		assert.NoError(t, trie.Put("GATTACA", 1))
+
+		assert.ErrorIs(t, trie.Put("GAUTACA", 2), ErrUnsupportedCharacter)
+		assert.ErrorIs(t, trie.Update("GAUTACA", 2), ErrUnsupportedCharacter)
+	})
+
+	t.Run("MutableEntry", func(t *testing.T) {
+		trie, err := New()
+		assert.NoError(t, err)
+
+		e := &countedEntry{value: "luffy", data: 1}
+		assert.NoError(t, trie.AddEntry(e))
+
+		assert.NoError(t, trie.Put("luffy", 2))
+		assert.NoError(t, trie.Update("luffy", 3))
+
+		actual, err := trie.Entry("luffy")
+		assert.NoError(t, err)
+		assert.Same(t, e, actual)
+		assert.Equal(t, 3, e.data)
+		assert.Equal(t, 2, e.updates)
+	})
+}
+
+// countedEntry is a MutableEntry that counts how many times its data has been replaced.
+type countedEntry struct {
+	value   string
+	data    any
+	updates int
+}
+
+func (e *countedEntry) Value() string {
+	return e.value
+}
+
+func (e *countedEntry) Data() any {
+	return e.data
+}
+
+func (e *countedEntry) SetData(data any) {
+	e.data = data
+	e.updates++
+}
+
+func TestTrie_RemoveAt(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	_, err = trie.RemoveAt(0)
+	assert.Error(t, err)
+
+	for _, v := range []string{"luffy", "zoro", "nami", "usopp", "sanji", "chopper"} {
+		assert.NoError(t, trie.AddEntry(NewEntry(v, len(v))))
+	}
+	assertContentEquals(t, trie, "[chopper, luffy, nami, sanji, usopp, zoro]")
+
+	tests := []struct {
+		index    int
+		expected string
+		content  string
+	}{
+		{index: 0, expected: "chopper", content: "[luffy, nami, sanji, usopp, zoro]"},
+		{index: 2, expected: "sanji", content: "[luffy, nami, usopp, zoro]"},
+		{index: 3, expected: "zoro", content: "[luffy, nami, usopp]"},
+		{index: 1, expected: "nami", content: "[luffy, usopp]"},
+	}
+
+	for _, tc := range tests {
+		size := trie.Len()
+		e, err := trie.RemoveAt(tc.index)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, e.Value())
+		assert.Equal(t, len(tc.expected), e.Data())
+		assertSize(t, trie, size-1)
+		assertContains(t, trie, tc.expected, false)
+		assertContentEquals(t, trie, tc.content)
+	}
+
+	for _, index := range []int{-1, 2} {
+		_, err = trie.RemoveAt(index)
+		assert.Error(t, err)
+	}
+	assertSize(t, trie, 2)
+}
+
+func TestTrie_Take(t *testing.T) {
+	digitizers := map[string]Digitizer{
+		"PrefixFree":    NewASCIIDigitizer(),
+		"NonPrefixFree": NewNonPrefixFreeASCIIDigitizer(),
+	}
+
+	for name, d := range digitizers {
+		t.Run(name, func(t *testing.T) {
+			trie, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+
+			data := map[string]any{"luffy": 1, "zoro": "three swords", "lu": []string{"straw", "hat"}}
+			for k, v := range data {
+				assert.NoError(t, trie.AddEntry(NewEntry(k, v)))
+			}
+
+			for _, k := range []string{"lu", "zoro"} {
+				e, err := trie.Take(k)
+				assert.NoError(t, err)
+				assert.Equal(t, k, e.Value())
+				assert.Equal(t, data[k], e.Data())
+				assertContains(t, trie, k, false)
+			}
+			assertSize(t, trie, 1)
+			assertContentEquals(t, trie, "[luffy]")
+
+			e, err := trie.Take("zoro")
+			assert.ErrorIs(t, err, hold.ErrNotFound)
+			assert.Nil(t, e)
+
+			_, err = trie.Take(" ")
+			assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+			e, err = trie.Take("luffy")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, e.Data())
+
+			_, err = trie.Take("luffy")
+			assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+		})
+	}
+}
+
+func TestTrie_Walk(t *testing.T) {
+	trie, err := New(WithDigitizer(NewNonPrefixFreeASCIIDigitizer()))
+	assert.NoError(t, err)
+	assert.NoError(t, trie.Add("cat", "do", "car"))
+
+	walk := func(order TraversalOrder, maxDepth int) []string {
+		var visited []string
+		err := trie.Walk(order, func(depth int, n Node) bool {
+			v := "-"
+			if n.IsLeaf() {
+				v = n.Value().Value()
+			}
+			visited = append(visited, fmt.Sprintf("%d:%s", depth, v))
+			return depth < maxDepth
+		})
+		assert.NoError(t, err)
+		return visited
+	}
+
+	assert.Equal(t, []string{"0:-", "1:-", "2:-", "3:car", "3:cat", "1:-", "2:do"}, walk(PreOrder, 3))
+	assert.Equal(t, []string{"0:-", "1:-", "1:-", "2:-", "2:do", "3:car", "3:cat"}, walk(BreadthFirst, 3))
+
+	assert.Equal(t, []string{"0:-", "1:-", "2:-", "1:-", "2:do"}, walk(PreOrder, 2))
+	assert.Equal(t, []string{"0:-", "1:-", "1:-", "2:-", "2:do"}, walk(BreadthFirst, 2))
+	assert.Equal(t, []string{"0:-"}, walk(PreOrder, 0))
+
+	assert.Error(t, trie.Walk(TraversalOrder(0), func(int, Node) bool { return true }))
+	assert.ErrorIs(t, trie.Walk(PreOrder, nil), hold.ErrValueRequired)
+
+	empty, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, empty.Walk(BreadthFirst, func(int, Node) bool { return true }))
+}
+
+func TestTrie_Stats(t *testing.T) {
+	trie, err := New(WithDigitizer(NewNonPrefixFreeASCIIDigitizer()))
+	assert.NoError(t, err)
+	assert.Equal(t, TrieStats{}, trie.Stats())
+
+	// root -> c -> a -> {r, t}, root -> d -> o
+	assert.NoError(t, trie.Add("cat", "do", "car"))
+	assert.Equal(t, TrieStats{NodeCount: 7, LeafCount: 3, MaxDepth: 3, AverageBranchingFactor: 1.5}, trie.Stats())
+
+	// root -> d -> o -> g
+	assert.NoError(t, trie.Add("dog"))
+	assert.Equal(t, TrieStats{NodeCount: 8, LeafCount: 4, MaxDepth: 3, AverageBranchingFactor: 1.4}, trie.Stats())
+
+	_, err = trie.Remove("cat")
+	assert.NoError(t, err)
+	assert.Equal(t, TrieStats{NodeCount: 7, LeafCount: 3, MaxDepth: 3, AverageBranchingFactor: 1.2}, trie.Stats())
+
+	s := Synchronized(trie)
+	assert.Equal(t, trie.Stats(), s.Stats())
+}
+
+func TestTrie_Compact(t *testing.T) {
+	trie, err := New(WithDigitizer(NewUnicodeDigitizer()))
+	assert.NoError(t, err)
+
+	for r := 'a'; r <= 'z'; r++ {
+		assert.NoError(t, trie.Add(string(r)+"ü"))
+	}
+
+	for r := 'a'; r < 'z'; r++ {
+		_, err := trie.Remove(string(r) + "ü")
+		assert.NoError(t, err)
+	}
+
+	root := rootOf(trie).(*node)
+	assert.Len(t, root.children, 1)
+	assert.Greater(t, cap(root.children), 1)
+
+	trie.Compact()
+	assert.Equal(t, 1, cap(root.children))
+	assert.Equal(t, 1, cap(root.indices))
+	assert.Equal(t, []string{"zü"}, trie.Values())
+	assertContains(t, trie, "zü", true)
+
+	assert.NoError(t, trie.Add("aü"))
+	assert.Equal(t, []string{"aü", "zü"}, trie.Values())
+
+	empty, err := New()
+	assert.NoError(t, err)
+	empty.Compact()
+	assert.True(t, empty.IsEmpty())
+}
+
+func TestTrie_SubtreeSize(t *testing.T) {
+	digitizers := map[string]Digitizer{
+		"ASCII":              NewASCIIDigitizer(),
+		"NonPrefixFreeASCII": NewNonPrefixFreeASCIIDigitizer(),
+	}
+
+	for name, d := range digitizers {
+		t.Run(name, func(t *testing.T) {
+			counted, err := New(WithDigitizer(d), WithSubtreeCounts(true))
+			assert.NoError(t, err)
+
+			plain, err := New(WithDigitizer(d))
+			assert.NoError(t, err)
+
+			prefixes := []string{"c", "ca", "car", "card", "cards", "cat", "d", "do", "dog", "x"}
+			assertCounts := func(t *testing.T, counted Trie) {
+				t.Helper()
+				for _, p := range prefixes {
+					expected, err := plain.CountCompletions(p)
+					assert.NoError(t, err)
+
+					n, err := counted.SubtreeSize(p)
+					assert.NoError(t, err)
+					assert.Equal(t, expected, n, "prefix = %s", p)
+
+					n, err = counted.CountCompletions(p)
+					assert.NoError(t, err)
+					assert.Equal(t, expected, n, "prefix = %s", p)
+				}
+			}
+
+			r := rand.New(rand.NewPCG(1, 2))
+			values := []string{"car", "card", "cards", "cat", "ca", "do", "dog", "dot", "c", "zebra"}
+			for range 500 {
+				v := values[r.IntN(len(values))]
+				if plain.Contains(v) {
+					_, err := plain.Remove(v)
+					assert.NoError(t, err)
+					_, err = counted.Remove(v)
+					assert.NoError(t, err)
+				} else {
+					assert.NoError(t, plain.Add(v))
+					assert.NoError(t, counted.Add(v))
+				}
+
+				if !plain.IsEmpty() {
+					assertCounts(t, counted)
+				}
+			}
+
+			for _, v := range values {
+				if !plain.Contains(v) {
+					assert.NoError(t, plain.Add(v))
+					assert.NoError(t, counted.Add(v))
+				}
+			}
+			assertCounts(t, counted)
+
+			clone, err := counted.Clone()
+			assert.NoError(t, err)
+			assertCounts(t, clone)
+
+			_, err = plain.RemovePrefix("car")
+			assert.NoError(t, err)
+			_, err = counted.RemovePrefix("car")
+			assert.NoError(t, err)
+			assertCounts(t, counted)
+
+			counted.Clear()
+			_, err = counted.SubtreeSize("c")
+			assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+			assert.NoError(t, counted.Add("cat"))
+			n, err := counted.SubtreeSize("c")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+		})
+	}
+
+	counted, err := New(WithSubtreeCounts(true))
+	assert.NoError(t, err)
+	assert.NoError(t, counted.Add("cat"))
+
+	_, err = counted.SubtreeSize(" ")
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+	plain, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, plain.Add("cat"))
+
+	_, err = plain.SubtreeSize("c")
+	assert.Error(t, err)
+}
+
+func TestTrie_SuffixMatch(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, trie.Add("sing", "singer", "running", "ring", "ing", "king", "bingo", "sting", "in"))
+
+	l := list.List[string]{}
+	assert.NoError(t, trie.SuffixMatch("ing", &l))
+	assert.Equal(t, []string{"ing", "king", "ring", "running", "sing", "sting"}, l.Values())
+
+	l = list.List[string]{}
+	assert.NoError(t, trie.SuffixMatch("nning", &l))
+	assert.Equal(t, []string{"running"}, l.Values())
+
+	l = list.List[string]{}
+	assert.NoError(t, trie.SuffixMatch("xyz", &l))
+	assert.True(t, l.IsEmpty())
+
+	assert.ErrorIs(t, trie.SuffixMatch(" ", &l), hold.ErrValueRequired)
+
+	empty, err := New()
+	assert.NoError(t, err)
+	assert.ErrorIs(t, empty.SuffixMatch("ing", &l), hold.ErrCollectionEmpty)
+}
+
+func TestTrie_ContainsSubstring(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+	assert.NoError(t, trie.Add("category", "scatter", "dog", "cat", "concatenate", "act"))
+
+	l := list.List[string]{}
+	assert.NoError(t, trie.ContainsSubstring("cat", &l))
+	assert.Equal(t, []string{"cat", "category", "concatenate", "scatter"}, l.Values())
+
+	l = list.List[string]{}
+	assert.NoError(t, trie.ContainsSubstring("tt", &l))
+	assert.Equal(t, []string{"scatter"}, l.Values())
+
+	l = list.List[string]{}
+	assert.NoError(t, trie.ContainsSubstring("bird", &l))
+	assert.True(t, l.IsEmpty())
+
+	assert.ErrorIs(t, trie.ContainsSubstring("", &l), hold.ErrValueRequired)
+
+	empty, err := New()
+	assert.NoError(t, err)
+	assert.ErrorIs(t, empty.ContainsSubstring("cat", &l), hold.ErrCollectionEmpty)
+}
+
+func TestTrie_Compare(t *testing.T) {
+	d, err := NewAlphabetDigitizer("TGCA", true)
+	assert.NoError(t, err)
+
+	trie, err := New(WithDigitizer(d))
+	assert.NoError(t, err)
+
+	values := []string{"ACG", "T", "GAT", "TA", "CAT", "GA", "AAA"}
+	assert.NoError(t, trie.Add(values...))
+
+	assert.Negative(t, trie.Compare("T", "A"))
+	assert.Positive(t, trie.Compare("A", "T"))
+	assert.Negative(t, trie.Compare("GA", "GAT"))
+	assert.Positive(t, trie.Compare("GAT", "GA"))
+	assert.Zero(t, trie.Compare("CAT", "CAT"))
+
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, trie.Compare)
+	assert.Equal(t, trie.Values(), sorted)
+	assert.Equal(t, []string{"T", "TA", "GA", "GAT", "CAT", "ACG", "AAA"}, sorted)
+
+	s := Synchronized(trie)
+	assert.Negative(t, s.Compare("G", "C"))
+	assert.Negative(t, s.Compare("AU", "AZ"))
+}
+
+func TestTrie_RemoveMinMax(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	_, err = trie.RemoveMin()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	_, err = trie.RemoveMax()
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	values := []string{"pear", "apple", "peach", "fig", "app", "banana"}
+	assert.NoError(t, trie.Add(values...))
+	assert.NoError(t, trie.Put("fig", 7))
+
+	var drained []string
+	for !trie.IsEmpty() {
+		e, err := trie.RemoveMin()
+		assert.NoError(t, err)
+		drained = append(drained, e.Value())
+
+		if e.Value() == "fig" {
+			assert.Equal(t, 7, e.Data())
+		}
+	}
+	assert.True(t, slices.IsSorted(drained))
+	assert.Equal(t, []string{"app", "apple", "banana", "fig", "peach", "pear"}, drained)
+	assert.Zero(t, trie.Len())
+
+	assert.NoError(t, trie.Add(values...))
+	e, err := trie.RemoveMax()
+	assert.NoError(t, err)
+	assert.Equal(t, "pear", e.Value())
+	assertContains(t, trie, "pear", false)
+
+	e, err = trie.RemoveMin()
+	assert.NoError(t, err)
+	assert.Equal(t, "app", e.Value())
+	assertContains(t, trie, "app", false)
+	assertContains(t, trie, "apple", true)
+	assert.Equal(t, []string{"apple", "banana", "fig", "peach"}, trie.Values())
+}
+
+func TestTrie_Validate(t *testing.T) {
+	values := []string{"app", "apple", "banana", "fig", "peach", "pear"}
+
+	// newTrie creates a Trie holding the values, and returns it along with its leaves in iteration order, so that the
+	// links between them can be corrupted.
+	newTrie := func(t *testing.T, options ...func(*Option)) (*trie, []Leaf) {
+		tr, err := New(options...)
+		assert.NoError(t, err)
+		assert.NoError(t, tr.Add(values...))
+
+		var leaves []Leaf
+		for l := tr.(*trie).head.Next(); !l.IsTail(); l = l.Next() {
+			leaves = append(leaves, l)
+		}
+		assert.Len(t, leaves, len(values))
+		return tr.(*trie), leaves
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		tr, _ := newTrie(t)
+		assert.NoError(t, tr.Validate())
+		assert.NoError(t, Synchronized(tr).Validate())
+
+		_, err := tr.Take("banana")
+		assert.NoError(t, err)
+		_, err = tr.RemovePrefix("pea")
+		assert.NoError(t, err)
+		assert.NoError(t, tr.Validate())
+
+		nonPrefixFree, _ := newTrie(t, WithDigitizer(NewNonPrefixFreeASCIIDigitizer()))
+		assert.NoError(t, nonPrefixFree.Validate())
+
+		empty, err := New()
+		assert.NoError(t, err)
+		assert.NoError(t, empty.Validate())
+	})
+
+	tests := []struct {
+		name    string
+		corrupt func(tr *trie, leaves []Leaf)
+		message string
+	}{
+		{
+			name:    "SkippedLeaf",
+			corrupt: func(_ *trie, leaves []Leaf) { leaves[1].SetNext(leaves[3]) },
+			message: "leaf at position 2 is not linked back",
+		},
+		{
+			name:    "BackwardLink",
+			corrupt: func(_ *trie, leaves []Leaf) { leaves[4].SetPrevious(leaves[2]) },
+			message: "leaf at position 4 is not linked back",
+		},
+		{
+			name:    "BrokenLink",
+			corrupt: func(_ *trie, leaves []Leaf) { leaves[2].SetNext(nil) },
+			message: "leaf list is broken after 3 leaves",
+		},
+		{
+			name:    "RemovedLeaf",
+			corrupt: func(_ *trie, leaves []Leaf) { leaves[3].(*leaf).markDeleted() },
+			message: "leaf at position 3 has been removed",
+		},
+		{
+			name: "Cycle",
+			corrupt: func(_ *trie, leaves []Leaf) {
+				leaves[5].SetNext(leaves[2])
+				leaves[2].SetPrevious(leaves[5])
+			},
+			message: "leaf at position 2 is not linked back",
+		},
+		{
+			name: "Order",
+			corrupt: func(_ *trie, leaves []Leaf) {
+				e := leaves[1].Value()
+				leaves[1].SetValue(leaves[2].Value())
+				leaves[2].SetValue(e)
+			},
+			message: "leaf at position 2 is out of iteration order",
+		},
+		{
+			name:    "MissingEntry",
+			corrupt: func(_ *trie, leaves []Leaf) { leaves[0].SetValue(nil) },
+			message: "leaf at position 0 has no entry",
+		},
+		{
+			name:    "TailLink",
+			corrupt: func(tr *trie, leaves []Leaf) { tr.tail.SetPrevious(leaves[4]) },
+			message: "tail is not linked back",
+		},
+		{
+			name:    "LargerThanSize",
+			corrupt: func(tr *trie, _ []Leaf) { tr.size-- },
+			message: "found more leaves than size = 5",
+		},
+		{
+			name:    "Size",
+			corrupt: func(tr *trie, _ []Leaf) { tr.size++ },
+			message: "found 6 leaves, but size = 7",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tr, leaves := newTrie(t)
+			test.corrupt(tr, leaves)
+
+			err := tr.Validate()
+			assert.ErrorIs(t, err, ErrCorrupted)
+			assert.ErrorContains(t, err, test.message)
+		})
+	}
+}
+
+func TestTrie_RankedCompletions(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	// score rewards an exact match over any completion, and otherwise prefers completions that are closer in length
+	// to the prefix, boosted by the popularity held as the data of each Entry.
+	score := func(prefix string, e Entry) float64 {
+		if e.Value() == prefix {
+			return math.Inf(1)
+		}
+		return e.Data().(float64) / float64(len(e.Value())-len(prefix))
+	}
+
+	_, err = trie.RankedCompletions("car", score)
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	for v, popularity := range map[string]float64{
+		"car":       1,
+		"card":      2,
+		"cart":      2,
+		"carpet":    9,
+		"carnival":  5,
+		"cat":       100,
+		"cartwheel": 4,
+	} {
+		assert.NoError(t, trie.AddEntry(NewEntry(v, popularity)))
+	}
+
+	values := func(entries []Entry) []string {
+		v := make([]string, len(entries))
+		for i, e := range entries {
+			v[i] = e.Value()
+		}
+		return v
+	}
+
+	entries, err := trie.RankedCompletions("car", score)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"car", "carpet", "card", "cart", "carnival", "cartwheel"}, values(entries))
+
+	entries, err = trie.RankedCompletions("cart", score)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cart", "cartwheel"}, values(entries))
+
+	entries, err = trie.RankedCompletions("dog", score)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	var calls int
+	entries, err = Synchronized(trie).RankedCompletions("ca", func(prefix string, e Entry) float64 {
+		calls++
+		assert.Equal(t, "ca", prefix)
+		return 0
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, trie.Values(), values(entries))
+	assert.Equal(t, trie.Len(), calls)
+}
+
+func TestTrie_RemoveEntryFunc(t *testing.T) {
+	trie, err := New()
+	assert.NoError(t, err)
+
+	_, err = trie.RemoveEntryFunc(func(Entry) bool { return true })
+	assert.ErrorIs(t, err, hold.ErrCollectionEmpty)
+
+	assert.NoError(t, trie.AddEntry(NewEntry("cat", "tabby")))
+	assert.NoError(t, trie.AddEntry(NewEntry("category", "tabby")))
+	assert.NoError(t, trie.AddEntry(NewEntry("dog", "beagle")))
+
+	_, err = trie.RemoveEntryFunc(nil)
+	assert.ErrorIs(t, err, hold.ErrValueRequired)
+
+	// RemoveEntry matches on the value alone, whereas the predicate can also require the data to match.
+	calico := NewEntry("cat", "calico")
+	r, err := trie.RemoveEntryFunc(func(e Entry) bool {
+		return e.Value() == calico.Value() && e.Data() == calico.Data()
+	})
+	assert.NoError(t, err)
+	assert.False(t, r)
+	assertContains(t, trie, "cat", true)
+
+	tabby := NewEntry("cat", "tabby")
+	r, err = trie.RemoveEntryFunc(func(e Entry) bool {
+		return e.Value() == tabby.Value() && e.Data() == tabby.Data()
+	})
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assertContains(t, trie, "cat", false)
+	assertContains(t, trie, "category", true)
+
+	// Only the first matching Entry in iteration order is removed.
+	assert.NoError(t, trie.AddEntry(NewEntry("cow", "beagle")))
+	r, err = Synchronized(trie).RemoveEntryFunc(func(e Entry) bool { return e.Data() == "beagle" })
+	assert.NoError(t, err)
+	assert.True(t, r)
+	assert.Equal(t, []string{"category", "dog"}, trie.Values())
+	assert.NoError(t, trie.Validate())
+}
+
+func TestTrie_CompactNodes(t *testing.T) {
+	words := benchmarkWords(2000)
+
+	for _, d := range []Digitizer{NewASCIIDigitizer(), NewNonPrefixFreeASCIIDigitizer()} {
+		dense, err := New(WithDigitizer(d), WithCompactNodes(false))
+		assert.NoError(t, err)
+		assert.NoError(t, dense.Add(words...))
+
+		hybrid, err := New(WithDigitizer(d))
+		assert.NoError(t, err)
+		assert.NoError(t, hybrid.Add(words...))
+
+		compact, err := New(WithDigitizer(d), WithCompactNodes(true))
+		assert.NoError(t, err)
+		assert.NoError(t, compact.Add(words...))
+		assert.True(t, compact.(*trie).root.(*node).isSparse)
+		assert.False(t, dense.(*trie).root.(*node).isSparse)
+		assert.Len(t, dense.(*trie).root.Children(), d.Base())
+
+		// The root of the hybrid Trie has more than maxSparseChildren children, so it has been promoted.
+		assert.False(t, hybrid.(*trie).root.(*node).isSparse)
+
+		assert.Equal(t, dense.Values(), hybrid.Values())
+		assert.Equal(t, dense.Values(), compact.Values())
+		assert.True(t, slices.IsSortedFunc(compact.Values(), compact.Compare))
+		assert.NoError(t, compact.Validate())
+
+		for _, prefix := range []string{"a", "bc", "xyz"} {
+			expected := list.List[string]{}
+			assert.NoError(t, dense.Completions(prefix, &expected))
+
+			actual := list.List[string]{}
+			assert.NoError(t, compact.Completions(prefix, &actual))
+			assert.Equal(t, expected, actual)
+		}
+
+		for _, w := range words[:500] {
+			r, err := compact.Remove(w)
+			assert.NoError(t, err)
+			assert.True(t, r)
+		}
+		assert.Equal(t, len(words)-500, compact.Len())
+		assert.NoError(t, compact.Validate())
+
+		clone, err := compact.Clone()
+		assert.NoError(t, err)
+		assert.Equal(t, compactLayout, clone.(*trie).nodeLayout)
+		assert.Equal(t, compact.Values(), clone.Values())
+
+		clone, err = dense.Clone()
+		assert.NoError(t, err)
+		assert.Equal(t, denseLayout, clone.(*trie).nodeLayout)
+	}
+}
+
+func TestNode_Promote(t *testing.T) {
+	n := newNode(96, hybridLayout).(*node)
+	assert.True(t, n.isSparse)
+
+	// Children are added in descending order so that every insertion shifts the compact storage.
+	for i := maxSparseChildren; i > 0; i-- {
+		assert.NoError(t, n.AddChild(i*5, newNode(96, hybridLayout)))
+	}
+	assert.True(t, n.isSparse)
+	assert.Len(t, n.Children(), maxSparseChildren)
+	assert.Equal(t, 5, n.NextChildIndex(0))
+	assert.Equal(t, 15, n.PreviousChildIndex(19))
+
+	child := newNode(96, hybridLayout)
+	assert.NoError(t, n.AddChild(1, child))
+	assert.False(t, n.isSparse)
+	assert.Len(t, n.Children(), 96)
+	assert.Equal(t, maxSparseChildren+1, n.numChildren)
+
+	c, err := n.ChildAt(1)
+	assert.NoError(t, err)
+	assert.Same(t, child, c)
+
+	for i := 1; i <= maxSparseChildren; i++ {
+		c, err := n.ChildAt(i * 5)
+		assert.NoError(t, err)
+		assert.NotNil(t, c)
+		assert.Same(t, n, c.Parent())
+	}
+
+	c, err = n.ChildAt(2)
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+
+	assert.Equal(t, 1, n.NextChildIndex(0))
+	assert.Equal(t, 5, n.NextChildIndex(2))
+	assert.Equal(t, 15, n.PreviousChildIndex(19))
+	assert.ErrorContains(t, n.AddChild(5, newNode(96, hybridLayout)), "child exists")
+
+	assert.True(t, n.RemoveChildAt(1))
+	assert.False(t, n.RemoveChildAt(1))
+	assert.Equal(t, 5, n.NextChildIndex(0))
+	assert.Equal(t, maxSparseChildren, n.numChildren)
+
+	// Nodes of a Trie created using WithCompactNodes, or with a large capacity, are never promoted.
+	for _, n := range []*node{newNode(96, compactLayout).(*node), newNode(maxDenseCapacity+1, hybridLayout).(*node)} {
+		for i := 0; i <= maxSparseChildren*2; i++ {
+			assert.NoError(t, n.AddChild(i, newNode(96, hybridLayout)))
+		}
+		assert.True(t, n.isSparse)
+		assert.Len(t, n.Children(), maxSparseChildren*2+1)
+	}
+}
+
+func BenchmarkTrie_CountCompletions(b *testing.B) {
+	trie := benchmarkTrie(b, 10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := trie.CountCompletions("b"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTrie_CompletionsLen(b *testing.B) {
+	trie := benchmarkTrie(b, 10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l := list.List[string]{}
+		if err := trie.Completions("b", &l); err != nil {
+			b.Fatal(err)
+		}
+		_ = l.Len()
+	}
+}
+
+func BenchmarkTrie_TopCompletions(b *testing.B) {
+	trie := benchmarkTrie(b, 100000)
+	weight := func(e Entry) float64 {
+		return float64(len(e.Value()))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := trie.TopCompletions("b", 10, weight); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewFromSorted(b *testing.B) {
+	words := benchmarkWords(100000)
+	slices.Sort(words)
+
+	entries := make([]Entry, len(words))
+	for i, w := range words {
+		entries[i] = NewEntry(w, nil)
+	}
+
+	b.Run("NewFromSorted", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := NewFromSorted(entries); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("AddEntry", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			trie, err := New()
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			for _, e := range entries {
+				if err := trie.AddEntry(e); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkTrie_CompactNodes(b *testing.B) {
+	words := benchmarkWords(100000)
+
+	for _, storage := range []struct {
+		name    string
+		options []func(*Option)
+	}{
+		{name: "Dense", options: []func(*Option){WithCompactNodes(false)}},
+		{name: "Hybrid"},
+		{name: "Compact", options: []func(*Option){WithCompactNodes(true)}},
+	} {
+		trie, err := New(storage.options...)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if err := trie.Add(words...); err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(storage.name+"/Add", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				trie, err := New(storage.options...)
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				if err := trie.Add(words...); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(storage.name+"/Contains", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if !trie.Contains(words[i%len(words)]) {
+					b.Fatal("expected to contain word")
+				}
+			}
+		})
+
+		b.Run(storage.name+"/Completions", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				l := list.List[string]{}
+				if err := trie.Completions("b", &l); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkTrie_Memory(b *testing.B) {
+	words := benchmarkWords(100000)
+
+	for _, storage := range []struct {
+		name    string
+		options []func(*Option)
+	}{
+		{name: "Dense", options: []func(*Option){WithCompactNodes(false)}},
+		{name: "Hybrid"},
+		{name: "Compact", options: []func(*Option){WithCompactNodes(true)}},
+	} {
+		b.Run(storage.name, func(b *testing.B) {
+			var retained int64
+			for i := 0; i < b.N; i++ {
+				var before, after runtime.MemStats
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+
+				trie, err := New(storage.options...)
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				if err := trie.Add(words...); err != nil {
+					b.Fatal(err)
+				}
+
+				runtime.GC()
+				runtime.ReadMemStats(&after)
+				// HeapAlloc can decrease between the reads if a GC frees memory, so the difference is signed.
+				retained += int64(after.HeapAlloc) - int64(before.HeapAlloc)
+				runtime.KeepAlive(trie)
+			}
+			b.ReportMetric(float64(retained)/float64(b.N), "heap-B/op")
+		})
+	}
+}
+
+func BenchmarkTrie_FuzzyMatch(b *testing.B) {
+	trie := benchmarkTrie(b, 100000)
+	query := "abcdefgh"
+
+	b.Run("Trie", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			l := list.List[string]{}
+			if err := trie.FuzzyMatch(query, 2, &l); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("BruteForce", func(b *testing.B) {
+		values := trie.Values()
+		for i := 0; i < b.N; i++ {
+			l := list.List[string]{}
+			for _, v := range values {
+				if levenshtein(query, v) <= 2 {
+					_ = l.Add(v)
+				}
+			}
+		}
+	})
+}
+
+func assertError(t *testing.T, actual error, expected error) {
+	t.Helper()
+
+	if expected == nil && actual != nil {
+		t.Errorf("expected error to be nil, but got '%s'", actual)
+	} else {
+		return
+	}
+
+	if errors.Is(actual, expected) {
+		t.Errorf("expected error '%s', but got '%s'", expected, actual)
+	}
+}
+
+func assertContains(t *testing.T, collection hold.Collection[string], value string, expected bool) {
+	t.Helper()
+
+	if collection.Contains(value) != expected {
+		if expected {
+			t.Errorf("expected to contain node: %s", value)
+		} else {
+			t.Errorf("expected not to contain node: %s", value)
+		}
+	}
+}
+
+func assertNodeValue(t *testing.T, actual any, expected string) {
+	t.Helper()
+
+	if n, ok := actual.(string); ok {
+		if n != expected {
+			t.Errorf("expected content of '%s', but found '%s'", expected, n)
+		}
+	} else {
+		t.Errorf("expected type of 'string', but found '%v'", reflect.TypeOf(actual))
+	}
+}
+
+func assertSize(t *testing.T, collection hold.Collection[string], expected int) {
+	t.Helper()
+
+	actual := collection.Len()
+	if actual != expected {
+		t.Errorf("expected size of '%d', but found '%d'", expected, actual)
+	}
+}
+
+func assertContentEquals(t *testing.T, collection hold.Collection[string], expected string) {
+	t.Helper()
+
+	actual := fmt.Sprintf("%s", collection)
+	if actual != expected {
+		t.Errorf("expected content of '%s', but found '%s'", expected, actual)
+	}
+}
+
+func nodeOf(t Trie, value string) (Node, error) {
+	return t.(*trie).node(value)
+}
+
+func rootOf(t Trie) Node {
+	return t.(*trie).root
+}
+
+func benchmarkTrie(b *testing.B, size int) Trie {
+	b.Helper()
+
+	trie, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	if err := trie.Add(benchmarkWords(size)...); err != nil {
+		b.Fatal(err)
+	}
+	return trie
+}
+
+func benchmarkWords(size int) []string {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz"
+
+	r := rand.New(rand.NewPCG(1, 2))
+	seen := make(map[string]struct{}, size)
+	words := make([]string, 0, size)
+	for len(words) < size {
+		w := make([]byte, 3+r.IntN(8))
+		for i := range w {
+			w[i] = alphabet[r.IntN(len(alphabet))]
+		}
+
+		if _, ok := seen[string(w)]; !ok {
+			seen[string(w)] = struct{}{}
+			words = append(words, string(w))
+		}
+	}
+	return words
+}
+
+func levenshtein(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		diagonal := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			diagonal, row[j] = row[j], min(row[j]+1, row[j-1]+1, diagonal+cost)
+		}
 	}
+	return row[len(rb)]
 }
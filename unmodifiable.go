@@ -0,0 +1,157 @@
+package hold
+
+import "fmt"
+
+var (
+	_ Collection[any] = (*unmodifiableCollection[any])(nil)
+	_ Ordered[any]    = (*unmodifiableOrdered[any])(nil)
+	_ Sequence[any]   = (*unmodifiableSequence[any])(nil)
+)
+
+// Unmodifiable returns a read-only view of the provided Collection.
+//
+// Methods that read the Collection pass through to it, so the view reflects later changes made to the Collection
+// itself. Methods that would modify the Collection return ErrUnmodifiable instead, except for Clear, which has no
+// effect. The Iterator returned by the view does not support removing entries, even if the Iterator of the Collection
+// does.
+func Unmodifiable[E comparable](c Collection[E]) Collection[E] {
+	if u, ok := c.(*unmodifiableCollection[E]); ok {
+		return u
+	}
+	return &unmodifiableCollection[E]{c: c}
+}
+
+// UnmodifiableOrdered returns a read-only view of the provided Ordered collection, as described by Unmodifiable.
+func UnmodifiableOrdered[E comparable](o Ordered[E]) Ordered[E] {
+	if u, ok := o.(*unmodifiableOrdered[E]); ok {
+		return u
+	}
+	return &unmodifiableOrdered[E]{unmodifiableCollection: unmodifiableCollection[E]{c: o}, o: o}
+}
+
+// UnmodifiableSequence returns a read-only view of the provided Sequence, as described by Unmodifiable.
+func UnmodifiableSequence[E comparable](s Sequence[E]) Sequence[E] {
+	if u, ok := s.(*unmodifiableSequence[E]); ok {
+		return u
+	}
+	return &unmodifiableSequence[E]{unmodifiableCollection: unmodifiableCollection[E]{c: s}, s: s}
+}
+
+type unmodifiableIterator[E comparable] struct {
+	iter Iterator[E]
+}
+
+func (i *unmodifiableIterator[E]) HasNext() bool {
+	return i.iter.HasNext()
+}
+
+func (i *unmodifiableIterator[E]) Next() (E, error) {
+	return i.iter.Next()
+}
+
+type unmodifiableCollection[E comparable] struct {
+	c Collection[E]
+}
+
+func (u *unmodifiableCollection[E]) Add(...E) error {
+	return fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableCollection[E]) AddAll(Collection[E]) error {
+	return fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableCollection[E]) Clear() {}
+
+func (u *unmodifiableCollection[E]) Contains(entry E) bool {
+	return u.c.Contains(entry)
+}
+
+func (u *unmodifiableCollection[E]) IsEmpty() bool {
+	return u.c.IsEmpty()
+}
+
+func (u *unmodifiableCollection[E]) Iterate() Iterator[E] {
+	return &unmodifiableIterator[E]{iter: u.c.Iterate()}
+}
+
+func (u *unmodifiableCollection[E]) Len() int {
+	return u.c.Len()
+}
+
+func (u *unmodifiableCollection[E]) Remove(E) (bool, error) {
+	return false, fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableCollection[E]) Values() []E {
+	return u.c.Values()
+}
+
+func (u *unmodifiableCollection[E]) String() string {
+	return fmt.Sprintf("%v", u.c)
+}
+
+type unmodifiableOrdered[E comparable] struct {
+	unmodifiableCollection[E]
+	o Ordered[E]
+}
+
+func (u *unmodifiableOrdered[E]) Max() (E, error) {
+	return u.o.Max()
+}
+
+func (u *unmodifiableOrdered[E]) Min() (E, error) {
+	return u.o.Min()
+}
+
+func (u *unmodifiableOrdered[E]) Predecessor(entry E) (E, error) {
+	return u.o.Predecessor(entry)
+}
+
+func (u *unmodifiableOrdered[E]) Successor(entry E) (E, error) {
+	return u.o.Successor(entry)
+}
+
+type unmodifiableSequence[E comparable] struct {
+	unmodifiableCollection[E]
+	s Sequence[E]
+}
+
+func (u *unmodifiableSequence[E]) AddAt(int, E) error {
+	return fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableSequence[E]) AddFirst(E) error {
+	return fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableSequence[E]) AddLast(E) error {
+	return fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableSequence[E]) Index(entry E) (int, error) {
+	return u.s.Index(entry)
+}
+
+func (u *unmodifiableSequence[E]) IndexFrom(entry E, start int) (int, error) {
+	return u.s.IndexFrom(entry, start)
+}
+
+func (u *unmodifiableSequence[E]) RemoveAt(int) (E, error) {
+	var e E
+	return e, fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableSequence[E]) RemoveFirst() (E, error) {
+	var e E
+	return e, fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableSequence[E]) RemoveLast() (E, error) {
+	var e E
+	return e, fmt.Errorf("unmodifiable: %w", ErrUnmodifiable)
+}
+
+func (u *unmodifiableSequence[E]) ValueAt(index int) (E, error) {
+	return u.s.ValueAt(index)
+}
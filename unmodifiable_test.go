@@ -0,0 +1,119 @@
+package hold_test
+
+import (
+	"testing"
+
+	"github.com/transientvariable/hold"
+	"github.com/transientvariable/hold/list"
+	"github.com/transientvariable/hold/trie"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmodifiable(t *testing.T) {
+	l := list.List[string]{"luffy", "zoro", "nami"}
+	c := hold.Unmodifiable[string](&l)
+	assert.Same(t, c, hold.Unmodifiable(c))
+
+	assert.ErrorIs(t, c.Add("usopp"), hold.ErrUnmodifiable)
+	assert.ErrorIs(t, c.AddAll(&list.List[string]{"usopp"}), hold.ErrUnmodifiable)
+
+	removed, err := c.Remove("zoro")
+	assert.ErrorIs(t, err, hold.ErrUnmodifiable)
+	assert.False(t, removed)
+
+	c.Clear()
+	assert.Equal(t, list.List[string]{"luffy", "zoro", "nami"}, l)
+
+	assert.True(t, c.Contains("zoro"))
+	assert.False(t, c.Contains("usopp"))
+	assert.False(t, c.IsEmpty())
+	assert.Equal(t, 3, c.Len())
+	assert.Equal(t, []string{"luffy", "zoro", "nami"}, c.Values())
+	assert.Equal(t, "[luffy, zoro, nami]", c.(interface{ String() string }).String())
+
+	iter := c.Iterate()
+	_, ok := iter.(hold.MutableIterator[string])
+	assert.False(t, ok)
+
+	var values []string
+	for iter.HasNext() {
+		v, err := iter.Next()
+		assert.NoError(t, err)
+		values = append(values, v)
+	}
+	assert.Equal(t, []string{"luffy", "zoro", "nami"}, values)
+
+	assert.NoError(t, l.Add("usopp"))
+	assert.Equal(t, 4, c.Len())
+	assert.True(t, c.Contains("usopp"))
+}
+
+func TestUnmodifiableSequence(t *testing.T) {
+	l := list.List[string]{"luffy", "zoro", "nami", "zoro"}
+	s := hold.UnmodifiableSequence[string](&l)
+	assert.Same(t, s, hold.UnmodifiableSequence(s))
+
+	assert.ErrorIs(t, s.Add("usopp"), hold.ErrUnmodifiable)
+	assert.ErrorIs(t, s.AddAt(0, "usopp"), hold.ErrUnmodifiable)
+	assert.ErrorIs(t, s.AddFirst("usopp"), hold.ErrUnmodifiable)
+	assert.ErrorIs(t, s.AddLast("usopp"), hold.ErrUnmodifiable)
+
+	_, err := s.RemoveAt(0)
+	assert.ErrorIs(t, err, hold.ErrUnmodifiable)
+
+	_, err = s.RemoveFirst()
+	assert.ErrorIs(t, err, hold.ErrUnmodifiable)
+
+	_, err = s.RemoveLast()
+	assert.ErrorIs(t, err, hold.ErrUnmodifiable)
+	assert.Equal(t, list.List[string]{"luffy", "zoro", "nami", "zoro"}, l)
+
+	i, err := s.Index("zoro")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, i)
+
+	i, err = s.IndexFrom("zoro", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, i)
+
+	v, err := s.ValueAt(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "nami", v)
+
+	_, err = s.ValueAt(4)
+	assert.ErrorIs(t, err, hold.ErrBoundsOutOfRange)
+}
+
+func TestUnmodifiableOrdered(t *testing.T) {
+	tr, err := trie.New()
+	assert.NoError(t, err)
+	assert.NoError(t, tr.Add("zoro", "luffy", "nami"))
+
+	o := hold.UnmodifiableOrdered[string](tr)
+	assert.Same(t, o, hold.UnmodifiableOrdered(o))
+	assert.ErrorIs(t, o.Add("usopp"), hold.ErrUnmodifiable)
+
+	_, err = o.Remove("nami")
+	assert.ErrorIs(t, err, hold.ErrUnmodifiable)
+
+	o.Clear()
+	assert.Equal(t, 3, tr.Len())
+
+	v, err := o.Min()
+	assert.NoError(t, err)
+	assert.Equal(t, "luffy", v)
+
+	v, err = o.Max()
+	assert.NoError(t, err)
+	assert.Equal(t, "zoro", v)
+
+	v, err = o.Predecessor("nami")
+	assert.NoError(t, err)
+	assert.Equal(t, "luffy", v)
+
+	v, err = o.Successor("nami")
+	assert.NoError(t, err)
+	assert.Equal(t, "zoro", v)
+	assert.Equal(t, []string{"luffy", "nami", "zoro"}, o.Values())
+}